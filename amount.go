@@ -0,0 +1,156 @@
+package mpesa
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/pkg/errors"
+)
+
+// Amount is an amount of money in Kenyan shillings with cents precision.
+// It is stored as a whole number of cents, so no float arithmetic is involved.
+//
+// Amount is encoded to JSON as a number, e.g. 10 or 10.5, and decoded
+// both from numbers and strings, e.g. 10, 10.00 or "10.00".
+type Amount int64
+
+// KES returns the amount of whole shillings.
+func KES(shillings int64) Amount {
+	return Amount(shillings * 100)
+}
+
+// AmountFromCents returns the amount of cents.
+func AmountFromCents(cents int64) Amount {
+	return Amount(cents)
+}
+
+// ParseAmount parses the decimal amount of shillings, e.g. "10", "10.5", "-10.50" or "1,000.00".
+// Only plain decimals with at most two decimal places are accepted,
+// commas are allowed only as thousands separators.
+func ParseAmount(s string) (Amount, error) {
+	return parseAmount(strings.TrimSpace(s), true)
+}
+
+// parseAmount parses the amount of the grammar -?\d+(\.\d{1,2})?,
+// with thousands separators in the whole part if separators is true.
+func parseAmount(s string, separators bool) (Amount, error) {
+	digits := strings.TrimPrefix(s, "-")
+	whole, frac, hasFrac := strings.Cut(digits, ".")
+	if separators && strings.Contains(whole, ",") {
+		groups := strings.Split(whole, ",")
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return 0, errors.Errorf("invalid amount %q", s)
+		}
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				return 0, errors.Errorf("invalid amount %q", s)
+			}
+		}
+		whole = strings.Join(groups, "")
+	}
+	if !isDigits(whole) || hasFrac && !isDigits(frac) {
+		return 0, errors.Errorf("invalid amount %q", s)
+	}
+	if len(frac) > 2 {
+		return 0, errors.Errorf("amount %q has fractions of cents", s)
+	}
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	cents, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("amount %q is out of range", s)
+	}
+	if len(digits) != len(s) {
+		cents = -cents
+	}
+	return Amount(cents), nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Cents returns the amount in cents.
+func (a Amount) Cents() int64 {
+	return int64(a)
+}
+
+// Shillings returns the whole part of the amount in shillings.
+func (a Amount) Shillings() int64 {
+	return int64(a) / 100
+}
+
+// IsWhole reports whether the amount has no cents.
+// M-Pesa Express (STK push) supports only whole amounts.
+func (a Amount) IsWhole() bool {
+	return a%100 == 0
+}
+
+// String formats the amount as a decimal number of shillings, without cents if the amount is whole.
+func (a Amount) String() string {
+	if a.IsWhole() {
+		return strconv.FormatInt(a.Shillings(), 10)
+	}
+	return a.StringFixed()
+}
+
+// StringFixed formats the amount with two decimal places, e.g. "10.00".
+func (a Amount) StringFixed() string {
+	sign := ""
+	cents := int64(a)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	frac := strconv.FormatInt(cents%100, 10)
+	if len(frac) == 1 {
+		frac = "0" + frac
+	}
+	return sign + strconv.FormatInt(cents/100, 10) + "." + frac
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	v, err := parseAmount(s, false)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+func (a Amount) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString(a.String())
+}
+
+func (a *Amount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+		return
+	}
+	data := l.Raw()
+	if l.Ok() {
+		l.AddError(a.UnmarshalJSON(data))
+	}
+}
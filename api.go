@@ -2,8 +2,9 @@ package mpesa
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
-	"encoding/json"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
@@ -17,17 +18,36 @@ const (
 )
 
 const (
-	authHeader        = "Authorization"
-	contentTypeHeader = "Content-Type"
-	defaultTokenLive  = time.Minute * 45
+	authHeader           = "Authorization"
+	contentTypeHeader    = "Content-Type"
+	userAgentHeader      = "User-Agent"
+	idempotencyKeyHeader = "Idempotency-Key"
+	defaultTokenLive     = time.Minute * 45
 )
 
 var ErrTokenIsExpired = errors.New("token was expired")
 
-const TimestampLayout = "20060102150405"
+// API identifies a Daraja API.
+type API string
 
-func Timestamp(t time.Time) string {
-	return t.Format(TimestampLayout)
+const (
+	APIOAuth             API = "oauth"
+	APIC2BRegisterURL    API = "c2b_register_url"
+	APIC2BSimulate       API = "c2b_simulate"
+	APIB2C               API = "b2c"
+	APITransactionStatus API = "transaction_status"
+	APISTKPush           API = "stk_push"
+	APIReversal          API = "reversal"
+)
+
+var defaultPaths = map[API]string{
+	APIOAuth:             "oauth/v1/generate?grant_type=client_credentials",
+	APIC2BRegisterURL:    "mpesa/c2b/v1/registerurl",
+	APIC2BSimulate:       "mpesa/c2b/v1/simulate",
+	APIB2C:               "mpesa/b2c/v1/paymentrequest",
+	APITransactionStatus: "mpesa/transactionstatus/v1/query",
+	APISTKPush:           "mpesa/stkpush/v1/processrequest",
+	APIReversal:          "mpesa/reversal/v1/request",
 }
 
 // Service is an Mpesa Service
@@ -35,21 +55,38 @@ type Service struct {
 	appKey    string
 	appSecret string
 	endpoint  string
+	paths     map[API]string
 
 	authHeader string
 	// The OAuth access token expires after an hour, after which,
 	// you will need to generate another access token.
 	// On a production app, use a base64 library of the programming language you are using to build your app to get
 	// the Basic Auth string that you will then use to invoke our OAuth API to get an access token.
-	token      string
-	checkPoint time.Time
-
-	HTTPClient        *http.Client
-	TokenLiveDuration time.Duration
+	tokenStore        TokenStore
+	tokenLiveDuration time.Duration
+
+	httpClient  *http.Client
+	timeout     time.Duration
+	logger      *slog.Logger
+	userAgent   string
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter
+	breakers    *circuitBreakers
+
+	interceptors []Interceptor
+	logLevel     LogLevel
+	maskMSISDN   bool
+
+	strictResponseCode bool
+	decodePolicy       DecodePolicy
+	unknownFieldsHook  UnknownFieldsHook
+	clock              Clock
+	normalizeMSISDN    bool
+	validate           bool
 }
 
 // New return a new Mpesa Service
-func New(key, secret string, endpoint string) *Service {
+func New(key, secret string, endpoint string, opts ...Option) *Service {
 	if endpoint == "" {
 		endpoint = SandboxEndpoint
 	}
@@ -57,177 +94,450 @@ func New(key, secret string, endpoint string) *Service {
 	encoded := base64.StdEncoding.EncodeToString(b)
 	serviceAuthHeader := "Basic " + encoded
 
-	return &Service{
+	s := &Service{
 		appKey:            key,
 		appSecret:         secret,
 		endpoint:          endpoint,
+		paths:             make(map[API]string, len(defaultPaths)),
 		authHeader:        serviceAuthHeader,
-		TokenLiveDuration: defaultTokenLive,
-		HTTPClient:        http.DefaultClient,
+		tokenStore:        NewMemoryTokenStore(),
+		tokenLiveDuration: defaultTokenLive,
+		httpClient:        http.DefaultClient,
+		logger:            slog.New(discardHandler{}),
+		logLevel:          LogSummary,
+		retryPolicy:       DefaultRetryPolicy,
+		limiter:           newRateLimiter(),
+		clock:             systemClock{},
+		normalizeMSISDN:   true,
+		validate:          true,
+	}
+	for api, path := range defaultPaths {
+		s.paths[api] = path
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if c, ok := s.retryPolicy.Checker.(*StatusChecker); ok {
+		c.bind(s)
 	}
+	if s.logLevel != LogOff {
+		// Logging goes first so it sees exchanges after all other interceptors.
+		s.interceptors = append([]Interceptor{logInterceptor{
+			logger:     s.logger,
+			level:      s.logLevel,
+			maskMSISDN: s.maskMSISDN,
+		}}, s.interceptors...)
+	}
+	return s
+}
+
+func (s *Service) url(api API) string {
+	return s.endpoint + s.paths[api]
 }
 
 // Usually, service generate tokens on its own and you should not regenerate them manually.
-func (s *Service) GenerateNewAccessToken() (string, error) {
-	err := s.updateToken()
+func (s *Service) GenerateNewAccessToken(opts ...CallOption) (string, error) {
+	o := s.callOptions(opts)
+	ctx, cancel := o.context()
+	defer cancel()
+	token, err := s.updateToken(ctx, o)
 	if err != nil {
 		return "", err
 	}
-	return s.token, nil
+	return token.AccessToken, nil
 }
 
-func (s *Service) checkToken() error {
-	if len(s.token) == 0 || time.Since(s.checkPoint) > s.TokenLiveDuration {
-		return ErrTokenIsExpired
+func (s *Service) checkToken(ctx context.Context) (Token, error) {
+	token, err := s.tokenStore.Token(ctx)
+	if err != nil {
+		return Token{}, errors.Wrap(err, "load token")
 	}
-	return nil
+	if !token.Valid(s.clock.Now()) {
+		return Token{}, ErrTokenIsExpired
+	}
+	return token, nil
 }
 
 // Generate Mpesa Daraja Access Token
-func (s *Service) updateToken() error {
-	url := s.endpoint + "oauth/v1/generate?grant_type=client_credentials"
-	req, err := http.NewRequest(http.MethodGet, url, nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Add(authHeader, s.authHeader)
-
-	client := s.HTTPClient
-	if client == nil {
-		client = http.DefaultClient
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "could not send auth request")
-	}
-	dec := json.NewDecoder(resp.Body)
-	dec.DisallowUnknownFields()
-	if resp.StatusCode != http.StatusOK {
-		var apiErr APIError
-		if err := dec.Decode(&apiErr); err == nil {
-			return error(apiErr)
+func (s *Service) updateToken(ctx context.Context, o *callOptions) (Token, error) {
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url(APIOAuth), nil)
+		if err != nil {
+			return nil, err
 		}
-		return errors.New(resp.Status)
+		req.Header.Add(authHeader, s.authHeader)
+		return req, nil
 	}
 
 	var authResponse authResponse
-	if err := dec.Decode(&authResponse); err != nil {
-		return errors.Wrap(err, "could not decode auth response")
+	c := &call{api: APIOAuth, opts: o}
+	if err := s.do(ctx, c, newRequest, &authResponse); err != nil {
+		return Token{}, errors.Wrap(err, "could not get auth token")
 	}
 
-	s.token = authResponse.AccessToken
+	now := s.clock.Now()
+	token := Token{
+		AccessToken: authResponse.AccessToken,
+		ExpiresAt:   now.Add(s.tokenLiveDuration),
+	}
 	if authResponse.ExpiresIn != "" {
 		expSecs, err := strconv.Atoi(authResponse.ExpiresIn)
 		if err == nil {
-			s.TokenLiveDuration = time.Second * time.Duration(expSecs)
+			token.ExpiresAt = now.Add(time.Second * time.Duration(expSecs))
 		}
 	}
-	s.checkPoint = time.Now()
-	return nil
+	if err := s.tokenStore.SetToken(ctx, token); err != nil {
+		return Token{}, errors.Wrap(err, "store token")
+	}
+	s.logger.DebugContext(ctx, "mpesa: access token updated", "expires_at", token.ExpiresAt)
+	return token, nil
 }
 
-func (s *Service) roundTrip(reqBody interface{}, dest interface{}, url string) error {
-	if s.checkToken() != nil {
-		if err := s.updateToken(); err != nil {
+func (s *Service) roundTrip(api API, reqBody interface{}, dest interface{}, opts []CallOption) error {
+	o := s.callOptions(opts)
+	ctx, cancel := o.context()
+	defer cancel()
+
+	token, err := s.checkToken(ctx)
+	if err != nil {
+		// The meta describes the exchange of the call, not the token refresh, whose response has the token.
+		tokenOpts := *o
+		tokenOpts.meta = nil
+		if token, err = s.updateToken(ctx, &tokenOpts); err != nil {
 			return errors.Wrap(err, "update auth token")
 		}
 	}
 
-	data, err := json.Marshal(reqBody)
+	data, err := Marshal(reqBody)
 	if err != nil {
 		return errors.Wrap(err, "encode to json")
 	}
 
-	//fmt.Println(string(data))
-
-	r, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
-	if err != nil {
+	newRequest := func() (*http.Request, error) {
+		r, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url(api), bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		r.Header.Add(authHeader, "Bearer "+token.AccessToken)
+		r.Header.Add(contentTypeHeader, "application/json")
+		if o.idempotencyKey != "" {
+			r.Header.Set(idempotencyKeyHeader, o.idempotencyKey)
+		}
+		return r, nil
+	}
+	c := &call{
+		api:       api,
+		shortCode: requestShortCode(reqBody),
+		request:   reqBody,
+		body:      data,
+		opts:      o,
+	}
+	if err := s.do(ctx, c, newRequest, dest); err != nil {
 		return err
 	}
+	if s.strictResponseCode {
+		return checkResponseCode(api, dest)
+	}
+	return nil
+}
+
+// do sends requests built by newRequest until one of them succeeds
+// or the retry policy gives up, and decodes the response into dest.
+func (s *Service) do(ctx context.Context, c *call, newRequest func() (*http.Request, error), dest interface{}) error {
+	client := s.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	for attempt := 1; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return err
+		}
+		if s.userAgent != "" {
+			req.Header.Set(userAgentHeader, s.userAgent)
+		}
+		for key, values := range c.opts.header {
+			req.Header[key] = append(req.Header[key], values...)
+		}
 
-	r.Header.Add(authHeader, "Bearer "+s.token)
-	r.Header.Add(contentTypeHeader, "application/json")
+		if err := s.limiter.wait(ctx, s.clock.Now(), c.api, c.shortCode); err != nil {
+			return err
+		}
+		breaker := s.breakers.get(c.api)
+		if err := breaker.allow(s.clock.Now()); err != nil {
+			return err
+		}
+		ex := &Exchange{
+			API:         c.api,
+			ShortCode:   c.shortCode,
+			Attempt:     attempt,
+			Input:       c.request,
+			Request:     req,
+			RequestBody: c.body,
+		}
+		n, err := s.beforeSend(ex)
+		if err != nil {
+			breaker.record(s.clock.Now(), breakerIgnored)
+			ex.Err = err
+			s.afterReceive(ex, n)
+			return err
+		}
+		res := s.send(client, ex, dest)
+		breaker.record(s.clock.Now(), res.breaker)
+		if c.opts.meta != nil {
+			c.opts.meta.fill(ex)
+		}
+		if err := s.afterReceive(ex, n); err != nil {
+			return err
+		}
+		if ex.Err == nil || !res.retryable {
+			return ex.Err
+		}
+		retry, checkErr := s.retryPolicy.allow(ctx, c, attempt)
+		if checkErr != nil {
+			s.logger.WarnContext(ctx, "mpesa: could not verify idempotent request", "api", c.api, "error", checkErr)
+		}
+		if !retry {
+			return ex.Err
+		}
+		delay := s.retryPolicy.delay(attempt, res.retryAfter)
+		s.logger.DebugContext(ctx, "mpesa: retry request", "api", c.api, "attempt", attempt, "delay", delay, "error", ex.Err)
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
 
-	resp, err := s.HTTPClient.Do(r)
+// call describes a single call of a Service method.
+type call struct {
+	api       API
+	shortCode string
+	// request is the request model and body is its JSON, both are nil for OAuth.
+	request interface{}
+	body    []byte
+	opts    *callOptions
+}
+
+// requestShortCode returns the shortcode on behalf of which the request is made.
+func requestShortCode(req interface{}) string {
+	switch r := req.(type) {
+	case C2BRegisterURL:
+		return r.ShortCode
+	case C2B:
+		return r.ShortCode
+	case B2C:
+		return r.PartyA
+	case TransactionStatus:
+		return r.PartyA
+	case Payment:
+		return r.BusinessShortCode
+	case Reversal:
+		return r.ReceiverParty
+	}
+	return ""
+}
+
+// attemptResult describes the outcome of a single attempt.
+type attemptResult struct {
+	// Whether the failed attempt may be repeated and when.
+	retryable  bool
+	retryAfter time.Duration
+	breaker    breakerResult
+}
+
+// send sends the request of the exchange and decodes the response into dest.
+// The response and the error are stored in the exchange.
+func (s *Service) send(client *http.Client, ex *Exchange, dest interface{}) attemptResult {
+	start := time.Now()
+	resp, err := client.Do(ex.Request)
 	if err != nil {
-		return errors.Wrap(err, "could not send request")
+		ex.Latency = time.Since(start)
+		ex.Err = errors.Wrap(err, "could not send request")
+		if ex.Request.Context().Err() != nil {
+			return attemptResult{breaker: breakerIgnored}
+		}
+		return attemptResult{retryable: true, breaker: breakerFailure}
 	}
 	defer resp.Body.Close()
-	/*{
-		data, _ := ioutil.ReadAll(resp.Body)
-		fmt.Println(string(data))
-	}*/
-	dec := json.NewDecoder(resp.Body)
-	dec.DisallowUnknownFields()
+	ex.Response = resp
+	body, err := readBody(resp.Body)
+	ex.Latency = time.Since(start)
+	if err != nil {
+		ex.Err = errors.Wrap(err, "could not read response")
+		if ex.Request.Context().Err() != nil {
+			return attemptResult{breaker: breakerIgnored}
+		}
+		return attemptResult{retryable: safeAPIs[ex.API], breaker: breakerFailure}
+	}
+	ex.ResponseBody = body
+
 	if resp.StatusCode != http.StatusOK {
-		var apiErr APIError
-		if err := dec.Decode(&apiErr); err == nil {
-			return apiErr
+		apiErr := APIError{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       body,
+		}
+		// Bodies which are not Daraja errors are kept only in Body.
+		if err := Unmarshal(body, &apiErr); err != nil {
+			apiErr = APIError{StatusCode: resp.StatusCode, Header: resp.Header, Body: body}
+		}
+		ex.Output = apiErr
+		ex.Err = apiErr
+		res := attemptResult{
+			retryable:  apiErr.Retryable(),
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), s.clock.Now()),
 		}
-		return errors.New(resp.Status)
+		// Only errors of Daraja itself open the circuit, not errors of the request or the merchant.
+		if resp.StatusCode >= http.StatusInternalServerError {
+			res.breaker = breakerIgnored
+			if res.retryable {
+				res.breaker = breakerFailure
+			}
+		}
+		return res
 	}
 
-	if err := dec.Decode(dest); err != nil {
-		return errors.Wrap(err, "could not decode response")
+	if err := s.decode(ex, dest); err != nil {
+		ex.Err = err
+		return attemptResult{}
 	}
+	ex.Output = dest
+	return attemptResult{}
+}
 
-	return nil
+func (o *callOptions) context() (context.Context, context.CancelFunc) {
+	if o.timeout > 0 {
+		return context.WithTimeout(o.ctx, o.timeout)
+	}
+	return context.WithCancel(o.ctx)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
 }
 
-func (s *Service) C2BRegisterURL(c2BRegisterURL C2BRegisterURL) (*C2BRegisterURLResponse, error) {
-	url := s.endpoint + "mpesa/c2b/v1/registerurl"
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+// validateRequest validates the request if validation is enabled.
+func (s *Service) validateRequest(r interface{ Validate() error }) error {
+	if !s.validate {
+		return nil
+	}
+	return r.Validate()
+}
+
+func (s *Service) C2BRegisterURL(c2BRegisterURL C2BRegisterURL, opts ...CallOption) (*C2BRegisterURLResponse, error) {
+	if err := s.correlate(&c2BRegisterURL, opts); err != nil {
+		return nil, err
+	}
+	if err := s.validateRequest(c2BRegisterURL); err != nil {
+		return nil, err
+	}
 	var res C2BRegisterURLResponse
-	err := s.roundTrip(c2BRegisterURL, &res, url)
+	err := s.roundTrip(APIC2BRegisterURL, c2BRegisterURL, &res, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func (s *Service) C2BSimulation(c2b C2B) (*C2BResponse, error) {
-	url := s.endpoint + "mpesa/c2b/v1/simulate"
+func (s *Service) C2BSimulation(c2b C2B, opts ...CallOption) (*C2BResponse, error) {
+	if s.normalizeMSISDN {
+		c2b.Msisdn = normalizeMSISDN(c2b.Msisdn)
+	}
+	if err := s.correlate(&c2b, opts); err != nil {
+		return nil, err
+	}
+	if err := s.validateRequest(c2b); err != nil {
+		return nil, err
+	}
 	var res C2BResponse
-	err := s.roundTrip(c2b, &res, url)
+	err := s.roundTrip(APIC2BSimulate, c2b, &res, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func (s *Service) B2CRequest(b2c B2C) (*B2CResponse, error) {
-	url := s.endpoint + "mpesa/b2c/v1/paymentrequest"
+func (s *Service) B2CRequest(b2c B2C, opts ...CallOption) (*B2CResponse, error) {
+	if s.normalizeMSISDN {
+		b2c.PartyB = normalizeMSISDN(b2c.PartyB)
+	}
+	if b2c.OriginatorConversationID == "" {
+		b2c.OriginatorConversationID = s.callOptions(opts).idempotencyKey
+	}
+	if err := s.correlate(&b2c, opts); err != nil {
+		return nil, err
+	}
+	if err := s.validateRequest(b2c); err != nil {
+		return nil, err
+	}
 	var res B2CResponse
-	err := s.roundTrip(b2c, &res, url)
+	err := s.roundTrip(APIB2C, b2c, &res, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func (s *Service) TransactionStatus(status TransactionStatus) (*TransactionStatusResponse, error) {
-	url := s.endpoint + "mpesa/transactionstatus/v1/query"
+func (s *Service) TransactionStatus(status TransactionStatus, opts ...CallOption) (*TransactionStatusResponse, error) {
+	if err := s.correlate(&status, opts); err != nil {
+		return nil, err
+	}
+	if err := s.validateRequest(status); err != nil {
+		return nil, err
+	}
 	var res TransactionStatusResponse
-	err := s.roundTrip(status, &res, url)
+	err := s.roundTrip(APITransactionStatus, status, &res, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func (s *Service) MPESAOnlinePayment(payment Payment) (*PaymentResponse, error) {
-	url := s.endpoint + "mpesa/stkpush/v1/processrequest"
+func (s *Service) MPESAOnlinePayment(payment Payment, opts ...CallOption) (*PaymentResponse, error) {
+	if s.normalizeMSISDN {
+		payment.PartyA = normalizeMSISDN(payment.PartyA)
+		payment.PhoneNumber = normalizeMSISDN(payment.PhoneNumber)
+	}
+	if err := s.correlate(&payment, opts); err != nil {
+		return nil, err
+	}
+	if err := s.validateRequest(payment); err != nil {
+		return nil, err
+	}
 	var res PaymentResponse
-	err := s.roundTrip(payment, &res, url)
+	err := s.roundTrip(APISTKPush, payment, &res, opts)
 	if err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func (s *Service) Reversal(reversal Reversal) (*ReversalResponse, error) {
-	url := s.endpoint + "mpesa/reversal/v1/request"
-	var res ReversalResponse
-	err := s.roundTrip(reversal, &res, url)
+func (s *Service) Reversal(reversal Reversal, opts ...CallOption) (*ReversalAcknowledgement, error) {
+	if err := s.correlate(&reversal, opts); err != nil {
+		return nil, err
+	}
+	if err := s.validateRequest(reversal); err != nil {
+		return nil, err
+	}
+	var res ReversalAcknowledgement
+	err := s.roundTrip(APIReversal, reversal, &res, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,202 @@
+package mpesa
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCircuitOpen is returned without sending the request when the circuit breaker of the API is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is a state of the circuit breaker.
+type CircuitState int
+
+const (
+	// Requests are sent as usual.
+	CircuitClosed CircuitState = iota
+	// Requests fail fast with ErrCircuitOpen.
+	CircuitOpen
+	// A limited number of probe requests is sent to check whether Daraja has recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreakerSettings configures circuit breakers of the Service.
+// Every API has its own circuit breaker.
+type CircuitBreakerSettings struct {
+	// Number of consecutive failures (network errors and 5xx responses) which opens the circuit.
+	FailureThreshold int
+	// How long the circuit stays open before probe requests are allowed.
+	OpenTimeout time.Duration
+	// Number of probe requests in the half-open state.
+	// The circuit closes when all of them succeed and opens again on the first failure.
+	HalfOpenProbes int
+}
+
+// WithCircuitBreaker enables circuit breakers of the Service.
+func WithCircuitBreaker(settings CircuitBreakerSettings) Option {
+	return func(s *Service) {
+		if settings.FailureThreshold < 1 {
+			settings.FailureThreshold = 1
+		}
+		if settings.HalfOpenProbes < 1 {
+			settings.HalfOpenProbes = 1
+		}
+		s.breakers = &circuitBreakers{
+			settings: settings,
+			breakers: make(map[API]*circuitBreaker),
+		}
+	}
+}
+
+// CircuitState returns the state of the circuit breaker of api.
+// It is always CircuitClosed when circuit breakers are disabled.
+func (s *Service) CircuitState(api API) CircuitState {
+	return s.breakers.get(api).currentState(s.clock.Now())
+}
+
+// CircuitStates returns states of all circuit breakers which were used. Useful for health checks.
+func (s *Service) CircuitStates() map[API]CircuitState {
+	states := make(map[API]CircuitState)
+	if s.breakers == nil {
+		return states
+	}
+	now := s.clock.Now()
+	s.breakers.mu.Lock()
+	defer s.breakers.mu.Unlock()
+	for api, b := range s.breakers.breakers {
+		states[api] = b.currentState(now)
+	}
+	return states
+}
+
+type circuitBreakers struct {
+	settings CircuitBreakerSettings
+
+	mu       sync.Mutex
+	breakers map[API]*circuitBreaker
+}
+
+// get returns the circuit breaker of api or nil if circuit breakers are disabled.
+func (c *circuitBreakers) get(api API) *circuitBreaker {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.breakers[api]
+	if !ok {
+		b = &circuitBreaker{settings: c.settings}
+		c.breakers[api] = b
+	}
+	return b
+}
+
+// breakerResult is an outcome of the request from the point of view of the circuit breaker.
+type breakerResult int
+
+const (
+	breakerSuccess breakerResult = iota
+	breakerFailure
+	// The request was aborted by the caller and tells nothing about Daraja health.
+	breakerIgnored
+)
+
+type circuitBreaker struct {
+	settings CircuitBreakerSettings
+
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	probes    int
+	successes int
+}
+
+func (b *circuitBreaker) currentState(now time.Time) CircuitState {
+	if b == nil {
+		return CircuitClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && now.Sub(b.openedAt) >= b.settings.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether the request may be sent.
+func (b *circuitBreaker) allow(now time.Time) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen {
+		if now.Sub(b.openedAt) < b.settings.OpenTimeout {
+			return ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		b.probes = 0
+		b.successes = 0
+	}
+	if b.state == CircuitHalfOpen {
+		if b.probes >= b.settings.HalfOpenProbes {
+			return ErrCircuitOpen
+		}
+		b.probes++
+	}
+	return nil
+}
+
+// record updates the state with the result of the allowed request.
+func (b *circuitBreaker) record(now time.Time, result breakerResult) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitClosed:
+		switch result {
+		case breakerSuccess:
+			b.failures = 0
+		case breakerFailure:
+			b.failures++
+			if b.failures >= b.settings.FailureThreshold {
+				b.open(now)
+			}
+		}
+	case CircuitHalfOpen:
+		switch result {
+		case breakerSuccess:
+			b.successes++
+			if b.successes >= b.settings.HalfOpenProbes {
+				b.state = CircuitClosed
+				b.failures = 0
+			}
+		case breakerFailure:
+			b.open(now)
+		case breakerIgnored:
+			b.probes--
+		}
+	}
+}
+
+func (b *circuitBreaker) open(now time.Time) {
+	b.state = CircuitOpen
+	b.openedAt = now
+}
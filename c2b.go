@@ -0,0 +1,43 @@
+package mpesa
+
+import "time"
+
+// C2BResultCode is the result code of the reply to the C2B validation request.
+type C2BResultCode string
+
+const (
+	C2BAccepted C2BResultCode = "0"
+
+	C2BRejectInvalidMSISDN        C2BResultCode = "C2B00011"
+	C2BRejectInvalidAccountNumber C2BResultCode = "C2B00012"
+	C2BRejectInvalidAmount        C2BResultCode = "C2B00013"
+	C2BRejectInvalidKYCDetails    C2BResultCode = "C2B00014"
+	C2BRejectInvalidShortCode     C2BResultCode = "C2B00015"
+	C2BRejectOtherError           C2BResultCode = "C2B00016"
+)
+
+// C2BAccept returns the reply accepting the payment.
+// It is also the reply to the confirmation request.
+func C2BAccept() C2BReply {
+	return C2BReply{ResultCode: C2BAccepted, ResultDesc: "Accepted"}
+}
+
+// C2BReject returns the reply rejecting the payment with the code, e.g. C2BRejectInvalidAccountNumber.
+func C2BReject(code C2BResultCode) C2BReply {
+	return C2BReply{ResultCode: code, ResultDesc: "Rejected"}
+}
+
+// Accepted reports whether the reply accepts the payment.
+func (r C2BReply) Accepted() bool {
+	return r.ResultCode == C2BAccepted
+}
+
+// TransactionTime parses TransTime in EAT.
+func (r C2BValidationRequest) TransactionTime() (time.Time, error) {
+	return ParseTimestamp(r.TransTime)
+}
+
+// TransactionTime parses TransTime in EAT.
+func (r C2BConfirmationRequest) TransactionTime() (time.Time, error) {
+	return ParseTimestamp(r.TransTime)
+}
@@ -0,0 +1,126 @@
+package mpesa
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrMissingItem is returned by callback accessors when the callback has no requested item.
+var ErrMissingItem = errors.New("callback item is missing")
+
+// item returns the value of the callback metadata item.
+func (cb *PaymentCallback) item(name string) (json.RawMessage, bool) {
+	for _, item := range cb.Body.STKCallback.CallbackMetadata.Item {
+		if item.Name == name {
+			return item.Value, len(item.Value) != 0
+		}
+	}
+	return nil, false
+}
+
+// Amount returns the paid amount. Only successful callbacks have it.
+func (cb *PaymentCallback) Amount() (Amount, error) {
+	var md struct {
+		Amount Amount `mpesa:"Amount"`
+	}
+	err := decodeParameters(cb.item, &md)
+	return md.Amount, err
+}
+
+// PaymentMetadata is the parsed metadata of a successful STK callback.
+type PaymentMetadata struct {
+	Amount             Amount `mpesa:"Amount"`
+	MpesaReceiptNumber string `mpesa:"MpesaReceiptNumber"`
+	// Balance is zero if the callback has no balance, which is usual.
+	Balance Amount `mpesa:"Balance,optional"`
+	// TransactionDate in EAT.
+	TransactionDate time.Time `mpesa:"TransactionDate"`
+	PhoneNumber     MSISDN    `mpesa:"PhoneNumber"`
+}
+
+// Metadata parses the callback metadata. Only successful callbacks have it,
+// for other callbacks ErrMissingItem is returned.
+func (cb *PaymentCallback) Metadata() (PaymentMetadata, error) {
+	var md PaymentMetadata
+	err := cb.DecodeMetadata(&md)
+	return md, err
+}
+
+// DecodeMetadata decodes the callback metadata items into the struct pointed to by v,
+// like ResultParameters.Decode does.
+func (cb *PaymentCallback) DecodeMetadata(v interface{}) error {
+	return decodeParameters(cb.item, v)
+}
+
+// rawString returns the unquoted JSON string or the JSON number as is.
+func rawString(value json.RawMessage) string {
+	if s, err := strconv.Unquote(string(value)); err == nil {
+		return s
+	}
+	return string(value)
+}
+
+// TransactionAmount returns the paid amount. Only successful results have it.
+func (cb *B2CCallback) TransactionAmount() (Amount, error) {
+	var p struct {
+		TransactionAmount Amount `mpesa:"TransactionAmount"`
+	}
+	err := cb.Result.ResultParameters.ResultParameter.Decode(&p)
+	return p.TransactionAmount, err
+}
+
+// B2CResultParameters are the parsed result parameters of a successful B2C callback.
+type B2CResultParameters struct {
+	TransactionAmount  Amount `mpesa:"TransactionAmount"`
+	TransactionReceipt string `mpesa:"TransactionReceipt"`
+	// RecipientIsRegisteredCustomer is parsed from B2CRecipientIsRegisteredCustomer: "Y" or "N".
+	RecipientIsRegisteredCustomer    bool   `mpesa:"B2CRecipientIsRegisteredCustomer"`
+	ChargesPaidAccountAvailableFunds Amount `mpesa:"B2CChargesPaidAccountAvailableFunds"`
+	// ReceiverPhone and ReceiverName are parsed from ReceiverPartyPublicName, e.g. "254708374149 - John Doe".
+	ReceiverPhone MSISDN `mpesa:"ReceiverPartyPublicName,phone"`
+	ReceiverName  string `mpesa:"ReceiverPartyPublicName,name"`
+	// TransactionCompletedDateTime in EAT.
+	TransactionCompletedDateTime time.Time `mpesa:"TransactionCompletedDateTime"`
+	UtilityAccountAvailableFunds Amount    `mpesa:"B2CUtilityAccountAvailableFunds"`
+	WorkingAccountAvailableFunds Amount    `mpesa:"B2CWorkingAccountAvailableFunds"`
+}
+
+// Parameters parses the result parameters. Only successful results have them,
+// for other results ErrMissingItem is returned.
+func (cb *B2CCallback) Parameters() (B2CResultParameters, error) {
+	var p B2CResultParameters
+	err := cb.Result.ResultParameters.ResultParameter.Decode(&p)
+	return p, err
+}
+
+// ReversalResultParameters are the parsed result parameters of a successful reversal.
+type ReversalResultParameters struct {
+	Amount                Amount `mpesa:"Amount"`
+	OriginalTransactionID string `mpesa:"OriginalTransactionID"`
+	Charge                Amount `mpesa:"Charge,optional"`
+	// TransCompletedTime in EAT.
+	TransCompletedTime time.Time `mpesa:"TransCompletedTime"`
+	// DebitAccountBalance is the balance of the account, e.g. "Utility Account|KES|346768.00|346768.00|0.00|0.00".
+	DebitAccountBalance string `mpesa:"DebitAccountBalance,optional"`
+	// Public names of the parties, e.g. "254708374149 - John Doe" and "600992 - Safaricom Daraja".
+	CreditPartyPublicName string `mpesa:"CreditPartyPublicName,optional"`
+	DebitPartyPublicName  string `mpesa:"DebitPartyPublicName,optional"`
+}
+
+// Parameters parses the result parameters. Only successful results have them,
+// for other results ErrMissingItem is returned.
+func (r *ReversalResponse) Parameters() (ReversalResultParameters, error) {
+	var p ReversalResultParameters
+	err := r.Result.ResultParameters.ResultParameter.Decode(&p)
+	return p, err
+}
+
+// splitPublicName splits the public name of the party, e.g. "254708374149 - John Doe", to the phone and the name.
+func splitPublicName(s string) (phone, name string) {
+	phone, name, _ = strings.Cut(s, " - ")
+	return strings.TrimSpace(phone), strings.TrimSpace(name)
+}
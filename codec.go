@@ -0,0 +1,88 @@
+package mpesa
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/pkg/errors"
+)
+
+// Marshal encodes v to JSON with the generated easyjson codec.
+// Types without the codec are encoded with encoding/json.
+func Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(easyjson.Marshaler)
+	if !ok {
+		return json.Marshal(v)
+	}
+	// Writer keeps the data in pooled chunks until it is built.
+	w := jwriter.Writer{}
+	m.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// Unmarshal decodes JSON data into v with the generated easyjson codec.
+// Types without the codec are decoded with encoding/json.
+// Unlike the json.Unmarshaler implementation of models, it does not validate the data twice.
+func Unmarshal(data []byte, v interface{}) error {
+	u, ok := v.(easyjson.Unmarshaler)
+	if !ok {
+		return json.Unmarshal(data, v)
+	}
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// maxPooledBuffer is the capacity of buffers which are not returned to the pool,
+// so rare huge bodies do not stay in memory.
+const maxPooledBuffer = 64 << 10
+
+// Decode reads JSON from r into v, e.g. a callback from the body of the HTTP request:
+//
+//	var cb mpesa.PaymentCallback
+//	err := mpesa.Decode(r.Body, &cb)
+//
+// The data is read into a pooled buffer, so decoding a stream of callbacks does not allocate buffers.
+func Decode(r io.Reader, v interface{}) error {
+	buf := getBuffer()
+	defer putBuffer(buf)
+	if _, err := buf.ReadFrom(r); err != nil {
+		return errors.Wrap(err, "could not read json")
+	}
+	return Unmarshal(buf.Bytes(), v)
+}
+
+// readBody reads the body of the response through a pooled buffer and returns its copy of the exact size,
+// which is kept by Exchange, APIError and ResponseMeta.
+func readBody(r io.Reader) ([]byte, error) {
+	buf := getBuffer()
+	defer putBuffer(buf)
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	body := make([]byte, buf.Len())
+	copy(body, buf.Bytes())
+	return body, nil
+}
+
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBuffer {
+		buf.Reset()
+		bufferPool.Put(buf)
+	}
+}
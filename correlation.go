@@ -0,0 +1,202 @@
+package mpesa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CorrelationParam is the query parameter of result URLs which carries the correlation metadata.
+const CorrelationParam = "mpesa_correlation"
+
+// Correlation is the metadata of the caller attached to the request and extracted from its callback,
+// e.g. the order ID.
+type Correlation map[string]string
+
+// correlationMarker starts the encoded metadata, so it is not confused with ordinary values,
+// e.g. the account reference "INV=12" entered by the customer.
+const correlationMarker = "~"
+
+// Encode encodes the metadata as the URL query with sorted keys after the marker, e.g. "~order=42".
+func (c Correlation) Encode() string {
+	values := make(url.Values, len(c))
+	for k, v := range c {
+		values.Set(k, v)
+	}
+	return correlationMarker + values.Encode()
+}
+
+// parseCorrelation parses the metadata encoded by Encode. Values without the marker,
+// e.g. ordinary account references, are ignored.
+func parseCorrelation(s string) Correlation {
+	if !strings.HasPrefix(s, correlationMarker) {
+		return nil
+	}
+	values, err := url.ParseQuery(strings.TrimPrefix(s, correlationMarker))
+	if err != nil || len(values) == 0 {
+		return nil
+	}
+	c := make(Correlation, len(values))
+	for k := range values {
+		c[k] = values.Get(k)
+	}
+	return c
+}
+
+// CorrelationCarrier is the field of the request which carries the correlation metadata.
+type CorrelationCarrier int
+
+const (
+	// CorrelateURL adds the metadata to the query of ResultURL and QueueTimeOutURL,
+	// or CallBackURL of M-Pesa Express. It is supported by B2C, transaction status, reversal and M-Pesa Express.
+	CorrelateURL CorrelationCarrier = iota
+	// CorrelateOccasion sets Occasion, which is limited to 100 characters and is sent back in ReferenceData.
+	// It is supported by B2C, transaction status and reversal.
+	CorrelateOccasion
+	// CorrelateAccountReference sets AccountReference of M-Pesa Express or BillRefNumber of C2B simulation,
+	// which is limited to 12 characters and is sent back as BillRefNumber of C2B requests.
+	CorrelateAccountReference
+)
+
+type correlationOption struct {
+	values  Correlation
+	carrier CorrelationCarrier
+}
+
+// WithCorrelation attaches the metadata to the request in the field chosen by carrier.
+// Use DecodeCallback to extract it from the callback.
+func WithCorrelation(c Correlation, carrier CorrelationCarrier) CallOption {
+	return func(o *callOptions) {
+		o.correlation = &correlationOption{values: c, carrier: carrier}
+	}
+}
+
+// correlate attaches the correlation metadata of the call options to the request.
+func (s *Service) correlate(req interface{}, opts []CallOption) error {
+	o := s.callOptions(opts)
+	if o.correlation == nil || len(o.correlation.values) == 0 {
+		return nil
+	}
+	return o.correlation.apply(req)
+}
+
+func (c *correlationOption) apply(req interface{}) error {
+	enc := c.values.Encode()
+	var err error
+	switch c.carrier {
+	case CorrelateURL:
+		switch r := req.(type) {
+		case *B2C:
+			r.ResultURL, r.QueueTimeOutURL, err = addCorrelationParam(r.ResultURL, r.QueueTimeOutURL, enc)
+		case *TransactionStatus:
+			r.ResultURL, r.QueueTimeOutURL, err = addCorrelationParam(r.ResultURL, r.QueueTimeOutURL, enc)
+		case *Reversal:
+			r.ResultURL, r.QueueTimeOutURL, err = addCorrelationParam(r.ResultURL, r.QueueTimeOutURL, enc)
+		case *Payment:
+			r.CallBackURL, _, err = addCorrelationParam(r.CallBackURL, "", enc)
+		default:
+			return errors.Errorf("correlation in URL is not supported by %T", req)
+		}
+		return err
+	case CorrelateOccasion:
+		switch r := req.(type) {
+		case *B2C:
+			return setCorrelationField(&r.Occasion, "Occasion", enc)
+		case *TransactionStatus:
+			return setCorrelationField(&r.Occasion, "Occasion", enc)
+		case *Reversal:
+			return setCorrelationField(&r.Occasion, "Occasion", enc)
+		}
+		return errors.Errorf("correlation in Occasion is not supported by %T", req)
+	case CorrelateAccountReference:
+		switch r := req.(type) {
+		case *Payment:
+			return setCorrelationField(&r.AccountReference, "AccountReference", enc)
+		case *C2B:
+			return setCorrelationField(&r.BillRefNumber, "BillRefNumber", enc)
+		}
+		return errors.Errorf("correlation in AccountReference is not supported by %T", req)
+	}
+	return errors.Errorf("unknown correlation carrier %d", c.carrier)
+}
+
+func addCorrelationParam(resultURL, timeoutURL, enc string) (string, string, error) {
+	var err error
+	if resultURL, err = withQueryParam(resultURL, enc); err != nil {
+		return "", "", errors.Wrap(err, "could not add correlation to result URL")
+	}
+	if timeoutURL, err = withQueryParam(timeoutURL, enc); err != nil {
+		return "", "", errors.Wrap(err, "could not add correlation to timeout URL")
+	}
+	return resultURL, timeoutURL, nil
+}
+
+func withQueryParam(rawURL, enc string) (string, error) {
+	if rawURL == "" {
+		return "", nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set(CorrelationParam, enc)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func setCorrelationField(field *string, name, enc string) error {
+	if *field != "" {
+		return errors.Errorf("could not attach correlation: %s is already set", name)
+	}
+	*field = enc
+	return nil
+}
+
+// CorrelationFromURL extracts the correlation metadata from the query of the callback URL.
+func CorrelationFromURL(u *url.URL) Correlation {
+	return parseCorrelation(u.Query().Get(CorrelationParam))
+}
+
+// DecodeCallback decodes the callback from the body of the request into v, e.g. *B2CCallback,
+// and extracts the correlation metadata attached with WithCorrelation.
+// The metadata is looked up in the query of the request URL, in Occasion of ReferenceData
+// and in BillRefNumber of C2B requests. Callbacks without the metadata result in nil Correlation.
+func DecodeCallback(r *http.Request, v interface{}) (Correlation, error) {
+	if err := Decode(r.Body, v); err != nil {
+		return nil, err
+	}
+	c := CorrelationFromURL(r.URL)
+	var carried string
+	switch cb := v.(type) {
+	case *B2CCallback:
+		carried = referenceOccasion(cb.Result.ReferenceData.ReferenceItem)
+	case *ReversalResponse:
+		carried = referenceOccasion(cb.Result.ReferenceData.ReferenceItem)
+	case *TransactionStatusCallback:
+		carried = referenceOccasion(cb.Result.ReferenceData.ReferenceItem)
+	case *C2BConfirmationRequest:
+		carried = cb.BillRefNumber
+	case *C2BValidationRequest:
+		carried = cb.BillRefNumber
+	}
+	for k, val := range parseCorrelation(carried) {
+		if c == nil {
+			c = make(Correlation)
+		}
+		if _, ok := c[k]; !ok {
+			c[k] = val
+		}
+	}
+	return c, nil
+}
+
+func referenceOccasion(items ResultParameters) string {
+	value, ok := items.Get("Occasion")
+	if !ok {
+		return ""
+	}
+	return rawString(value)
+}
@@ -0,0 +1,237 @@
+package mpesa
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/pkg/errors"
+)
+
+// DecodePolicy tells how to handle fields of Daraja responses which are not in the response models.
+type DecodePolicy int
+
+const (
+	// Responses with unknown fields are rejected. It is the default policy.
+	DecodeStrict DecodePolicy = iota
+	// Unknown fields are ignored. It is the fastest policy, because responses are not scanned for unknown fields.
+	DecodeLenient
+	// Unknown fields are ignored, but reported to the UnknownFieldsHook and logged.
+	DecodeLenientReport
+)
+
+// UnknownFieldsHook receives paths of unknown fields of the response of api,
+// e.g. "Body.stkCallback.NewField".
+type UnknownFieldsHook func(api API, fields []string)
+
+// WithDecodePolicy sets the policy of decoding responses.
+func WithDecodePolicy(policy DecodePolicy) Option {
+	return func(s *Service) {
+		s.decodePolicy = policy
+	}
+}
+
+// WithUnknownFieldsHook sets the hook called in DecodeLenientReport mode.
+func WithUnknownFieldsHook(hook UnknownFieldsHook) Option {
+	return func(s *Service) {
+		s.unknownFieldsHook = hook
+	}
+}
+
+// decode decodes the response body of the exchange into dest according to the decode policy.
+func (s *Service) decode(ex *Exchange, dest interface{}) error {
+	if err := Unmarshal(ex.ResponseBody, dest); err != nil {
+		return errors.Wrap(err, "could not decode response")
+	}
+	if s.decodePolicy == DecodeLenient {
+		return nil
+	}
+	fields, err := UnknownFields(ex.ResponseBody, dest)
+	if err != nil || len(fields) == 0 {
+		return err
+	}
+	if s.decodePolicy == DecodeStrict {
+		return errors.Errorf("could not decode response: unknown fields %s", strings.Join(fields, ", "))
+	}
+	s.logger.WarnContext(ex.Request.Context(), "mpesa: unknown fields in response", "api", ex.API, "fields", fields)
+	if s.unknownFieldsHook != nil {
+		s.unknownFieldsHook(ex.API, fields)
+	}
+	return nil
+}
+
+var (
+	jsonUnmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	easyjsonUnmarshalerType = reflect.TypeOf((*easyjson.Unmarshaler)(nil)).Elem()
+)
+
+// UnknownFields returns sorted paths of fields of the JSON data which are not decoded into v.
+// The data is scanned once without decoding, against the cached set of known fields of the type of v,
+// so checking a response costs much less than decoding it again.
+func UnknownFields(data []byte, v interface{}) ([]string, error) {
+	l := jlexer.Lexer{Data: data}
+	var fields []string
+	walkUnknownFields(&l, schemaOf(reflect.TypeOf(v)), nil, &fields)
+	if err := l.Error(); err != nil {
+		return nil, errors.Wrap(err, "could not decode json")
+	}
+	if len(fields) > 1 {
+		sort.Strings(fields)
+		fields = dedup(fields)
+	}
+	return fields, nil
+}
+
+// schema is the set of known fields of the type.
+type schema struct {
+	// skip means the value is not checked: it is a scalar or a type with custom decoding.
+	skip bool
+	// fields of the struct, nil for other types.
+	fields map[string]*schema
+	// exact means field names are matched exactly, otherwise case-insensitively like encoding/json does.
+	exact bool
+	// elem is the schema of elements of slices and arrays.
+	elem *schema
+}
+
+var schemas sync.Map // reflect.Type -> *schema
+
+func schemaOf(t reflect.Type) *schema {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return &schema{skip: true}
+	}
+	if sc, ok := schemas.Load(t); ok {
+		return sc.(*schema)
+	}
+	sc := buildSchema(t, make(map[reflect.Type]*schema))
+	schemas.Store(t, sc)
+	return sc
+}
+
+func buildSchema(t reflect.Type, seen map[reflect.Type]*schema) *schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if sc, ok := seen[t]; ok {
+		return sc
+	}
+	sc := &schema{}
+	seen[t] = sc
+	pt := reflect.PtrTo(t)
+	// Types with custom decoding, except generated easyjson models, decide on their own what they accept.
+	if pt.Implements(jsonUnmarshalerType) && !pt.Implements(easyjsonUnmarshalerType) {
+		sc.skip = true
+		return sc
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		sc.exact = pt.Implements(easyjsonUnmarshalerType)
+		sc.fields = make(map[string]*schema, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+			if name == "" {
+				name = f.Name
+			}
+			sc.fields[name] = buildSchema(f.Type, seen)
+		}
+	case reflect.Slice, reflect.Array:
+		sc.elem = buildSchema(t.Elem(), seen)
+	default:
+		sc.skip = true
+	}
+	return sc
+}
+
+// field returns the name and the schema of the field with the JSON name.
+func (sc *schema) field(name string) (string, *schema, bool) {
+	if f, ok := sc.fields[name]; ok {
+		return name, f, true
+	}
+	if sc.exact {
+		return "", nil, false
+	}
+	for n, f := range sc.fields {
+		if strings.EqualFold(n, name) {
+			return n, f, true
+		}
+	}
+	return "", nil, false
+}
+
+// fieldPath is the path of the field being scanned, built into a string only for unknown fields.
+type fieldPath struct {
+	parent *fieldPath
+	name   string
+}
+
+func (p *fieldPath) join(name string) string {
+	if p == nil {
+		// Copy the name, it may share memory with the lexer.
+		return string(append([]byte(nil), name...))
+	}
+	return p.parent.join(p.name) + "." + name
+}
+
+func walkUnknownFields(l *jlexer.Lexer, sc *schema, path *fieldPath, fields *[]string) {
+	switch {
+	case sc.skip:
+		l.SkipRecursive()
+	case sc.fields != nil:
+		if !l.IsDelim('{') {
+			l.SkipRecursive()
+			return
+		}
+		l.Delim('{')
+		for !l.IsDelim('}') && l.Ok() {
+			key := l.UnsafeString()
+			l.WantColon()
+			name, f, ok := sc.field(key)
+			if ok {
+				walkUnknownFields(l, f, &fieldPath{parent: path, name: name}, fields)
+			} else {
+				*fields = append(*fields, path.join(key))
+				l.SkipRecursive()
+			}
+			l.WantComma()
+		}
+		l.Delim('}')
+	case sc.elem != nil:
+		if !l.IsDelim('[') {
+			l.SkipRecursive()
+			return
+		}
+		l.Delim('[')
+		for !l.IsDelim(']') && l.Ok() {
+			walkUnknownFields(l, sc.elem, path, fields)
+			l.WantComma()
+		}
+		l.Delim(']')
+	default:
+		l.SkipRecursive()
+	}
+}
+
+func dedup(sorted []string) []string {
+	out := sorted[:1]
+	for _, f := range sorted[1:] {
+		if f != out[len(out)-1] {
+			out = append(out, f)
+		}
+	}
+	return out
+}
@@ -0,0 +1,125 @@
+package mpesa
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/pkg/errors"
+)
+
+// CommandID is a unique command for each transaction type.
+type CommandID string
+
+const (
+	CommandSalaryPayment          CommandID = "SalaryPayment"
+	CommandBusinessPayment        CommandID = "BusinessPayment"
+	CommandPromotionPayment       CommandID = "PromotionPayment"
+	CommandCustomerPayBillOnline  CommandID = "CustomerPayBillOnline"
+	CommandCustomerBuyGoodsOnline CommandID = "CustomerBuyGoodsOnline"
+	CommandTransactionStatusQuery CommandID = "TransactionStatusQuery"
+	CommandTransactionReversal    CommandID = "TransactionReversal"
+	CommandAccountBalance         CommandID = "AccountBalance"
+)
+
+// commandIDs are the commands allowed by each API.
+var commandIDs = map[API][]CommandID{
+	APIC2BSimulate:       {CommandCustomerPayBillOnline, CommandCustomerBuyGoodsOnline},
+	APISTKPush:           {CommandCustomerPayBillOnline, CommandCustomerBuyGoodsOnline},
+	APIB2C:               {CommandSalaryPayment, CommandBusinessPayment, CommandPromotionPayment},
+	APITransactionStatus: {CommandTransactionStatusQuery},
+	APIReversal:          {CommandTransactionReversal},
+}
+
+// ValidFor reports whether the command is allowed by the API.
+func (c CommandID) ValidFor(api API) bool {
+	for _, allowed := range commandIDs[api] {
+		if c == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// IdentifierType is a type of organization or party of the transaction.
+//
+// It is encoded to JSON as a string, e.g. "4", and decoded both from strings and numbers.
+type IdentifierType int
+
+const (
+	IdentifierMSISDN IdentifierType = 1
+	IdentifierTill   IdentifierType = 2
+	// IdentifierShortCode is an organization short code.
+	IdentifierShortCode IdentifierType = 4
+	// IdentifierOrganization is an organization identifier on M-Pesa, it is used by reversals.
+	IdentifierOrganization IdentifierType = 11
+)
+
+// identifierTypes are the identifier types allowed by each API.
+var identifierTypes = map[API][]IdentifierType{
+	APITransactionStatus: {IdentifierMSISDN, IdentifierTill, IdentifierShortCode},
+	APIReversal:          {IdentifierShortCode, IdentifierOrganization},
+}
+
+// ValidFor reports whether the identifier type is allowed by the API.
+func (t IdentifierType) ValidFor(api API) bool {
+	for _, allowed := range identifierTypes[api] {
+		if t == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (t IdentifierType) String() string {
+	return strconv.Itoa(int(t))
+}
+
+func (t IdentifierType) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.String())), nil
+}
+
+func (t *IdentifierType) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return errors.Errorf("invalid identifier type %s", data)
+	}
+	*t = IdentifierType(v)
+	return nil
+}
+
+func (t IdentifierType) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(t.String())
+}
+
+func (t *IdentifierType) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+		return
+	}
+	data := l.Raw()
+	if l.Ok() {
+		l.AddError(t.UnmarshalJSON(data))
+	}
+}
+
+// ResponseType is the default action of M-Pesa when the validation URL of C2B is not reachable.
+type ResponseType string
+
+const (
+	ResponseCompleted ResponseType = "Completed"
+	ResponseCancelled ResponseType = "Cancelled"
+)
+
+// Valid reports whether the response type is known.
+func (t ResponseType) Valid() bool {
+	return t == ResponseCompleted || t == ResponseCancelled
+}
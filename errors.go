@@ -0,0 +1,234 @@
+package mpesa
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrorClass tells how the caller should handle the error.
+type ErrorClass int
+
+const (
+	ErrorClassUnknown ErrorClass = iota
+	// The request may succeed if it is sent again later.
+	ErrorClassRetryable
+	// The request will fail again, no matter how many times it is sent.
+	ErrorClassPermanent
+	// Credentials or the access token are invalid.
+	ErrorClassAuth
+	// The request is malformed or has invalid values.
+	ErrorClassValidation
+)
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassRetryable:
+		return "retryable"
+	case ErrorClassPermanent:
+		return "permanent"
+	case ErrorClassAuth:
+		return "auth"
+	case ErrorClassValidation:
+		return "validation"
+	}
+	return "unknown"
+}
+
+// Errors for documented Daraja error codes. APIError matches them with errors.Is:
+//
+//	if errors.Is(err, mpesa.ErrSpikeArrest) { ... }
+var (
+	ErrInvalidRequest     = errors.New("mpesa: invalid request")
+	ErrInvalidToken       = errors.New("mpesa: invalid access token")
+	ErrInvalidAuthHeader  = errors.New("mpesa: invalid authentication header")
+	ErrInvalidGrantType   = errors.New("mpesa: invalid grant type")
+	ErrResourceNotFound   = errors.New("mpesa: resource not found")
+	ErrServerError        = errors.New("mpesa: server error")
+	ErrSpikeArrest        = errors.New("mpesa: spike arrest violation")
+	ErrQuotaViolation     = errors.New("mpesa: quota violation")
+	ErrServiceUnavailable = errors.New("mpesa: service unavailable")
+	ErrUnexpectedResponse = errors.New("mpesa: unexpected response")
+	// ErrNotAccepted is matched by ResponseCodeError.
+	ErrNotAccepted = errors.New("mpesa: request not accepted")
+)
+
+type errorKind struct {
+	err   error
+	class ErrorClass
+}
+
+// errorCodes maps Daraja error codes to errors and their classes.
+var errorCodes = map[string]errorKind{
+	"400.002.01": {ErrInvalidRequest, ErrorClassValidation},
+	"400.002.02": {ErrInvalidRequest, ErrorClassValidation},
+	"400.002.05": {ErrInvalidRequest, ErrorClassValidation},
+	"400.003.01": {ErrInvalidToken, ErrorClassAuth},
+	"400.008.01": {ErrInvalidAuthHeader, ErrorClassAuth},
+	"400.008.02": {ErrInvalidGrantType, ErrorClassAuth},
+	"401.002.01": {ErrInvalidToken, ErrorClassAuth},
+	"404.001.01": {ErrResourceNotFound, ErrorClassPermanent},
+	"404.001.03": {ErrInvalidToken, ErrorClassAuth},
+	"404.001.04": {ErrInvalidAuthHeader, ErrorClassAuth},
+	// 500.001.1001 is also returned for merchant configuration errors, it is classified by the message.
+	"500.001.1001": {ErrServerError, ErrorClassUnknown},
+	"500.002.1001": {ErrServerError, ErrorClassRetryable},
+	"500.003.1001": {ErrServerError, ErrorClassRetryable},
+	"500.003.02":   {ErrSpikeArrest, ErrorClassRetryable},
+	"500.003.03":   {ErrQuotaViolation, ErrorClassRetryable},
+	"503.001.01":   {ErrServiceUnavailable, ErrorClassRetryable},
+}
+
+// serverErrorMessages classify 500.001.1001 errors by parts of their messages in lower case.
+var serverErrorMessages = []struct {
+	part  string
+	class ErrorClass
+}{
+	{"merchant does not exist", ErrorClassPermanent},
+	{"wrong credentials", ErrorClassAuth},
+	{"initiator information is invalid", ErrorClassAuth},
+	{"invalid initiator", ErrorClassAuth},
+	{"unable to lock subscriber", ErrorClassRetryable},
+	{"system is busy", ErrorClassRetryable},
+	{"system busy", ErrorClassRetryable},
+}
+
+// Code returns the Daraja error code or empty string.
+func (r APIError) Code() string {
+	return sp(r.ErrorCode)
+}
+
+// Class classifies the error by its code or, if the code is unknown, by the HTTP status.
+func (r APIError) Class() ErrorClass {
+	if kind, ok := errorCodes[r.Code()]; ok {
+		if kind.class == ErrorClassUnknown {
+			msg := strings.ToLower(sp(r.ErrorMessage))
+			for _, m := range serverErrorMessages {
+				if strings.Contains(msg, m.part) {
+					return m.class
+				}
+			}
+		}
+		return kind.class
+	}
+	switch {
+	case r.StatusCode == http.StatusUnauthorized || r.StatusCode == http.StatusForbidden:
+		return ErrorClassAuth
+	case retryableStatus(r.StatusCode):
+		return ErrorClassRetryable
+	case r.StatusCode == http.StatusBadRequest:
+		return ErrorClassValidation
+	case r.StatusCode >= http.StatusBadRequest:
+		return ErrorClassPermanent
+	}
+	return ErrorClassUnknown
+}
+
+// Retryable reports whether the request may succeed if it is sent again.
+func (r APIError) Retryable() bool {
+	return r.Class() == ErrorClassRetryable
+}
+
+// Unwrap returns one of the errors of documented codes.
+// Responses which are not Daraja errors unwrap to ErrUnexpectedResponse.
+func (r APIError) Unwrap() error {
+	if kind, ok := errorCodes[r.Code()]; ok {
+		return kind.err
+	}
+	if r.ErrorCode == nil {
+		return ErrUnexpectedResponse
+	}
+	return nil
+}
+
+// ErrorClassOf returns the class of the APIError in the chain of err.
+func ErrorClassOf(err error) ErrorClass {
+	var apiErr APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Class()
+	}
+	var validationErr ValidationError
+	if errors.As(err, &validationErr) {
+		return ErrorClassValidation
+	}
+	return ErrorClassUnknown
+}
+
+// IsRetryable reports whether err is an APIError which may be retried.
+func IsRetryable(err error) bool {
+	return ErrorClassOf(err) == ErrorClassRetryable
+}
+
+// ResponseCodeError is returned in the strict response code mode
+// when Daraja acknowledges the request with a non-zero ResponseCode.
+type ResponseCodeError struct {
+	API                 API
+	ResponseCode        string
+	ResponseDescription string
+	// Decoded acknowledgement, e.g. *PaymentResponse.
+	Response interface{}
+}
+
+func (e *ResponseCodeError) Error() string {
+	return fmt.Sprintf("%s request is not accepted: %s - %s", e.API, e.ResponseCode, e.ResponseDescription)
+}
+
+func (e *ResponseCodeError) Unwrap() error {
+	return ErrNotAccepted
+}
+
+// WithStrictResponseCode makes Service methods return ResponseCodeError
+// when the ResponseCode of the acknowledgement is not "0".
+func WithStrictResponseCode(strict bool) Option {
+	return func(s *Service) {
+		s.strictResponseCode = strict
+	}
+}
+
+// ResponseCodeOf returns ResponseCode and ResponseDescription of the acknowledgement of the request,
+// e.g. *B2CResponse. They are empty for other types.
+func ResponseCodeOf(response interface{}) (code, description string) {
+	switch r := response.(type) {
+	case *PaymentResponse:
+		return r.ResponseCode, r.ResponseDescription
+	case *B2CResponse:
+		return r.ResponseCode, r.ResponseDescription
+	case *C2BResponse:
+		return r.ResponseCode, r.ResponseDescription
+	case *C2BRegisterURLResponse:
+		return r.ResponseCode, r.ResponseDescription
+	case *TransactionStatusResponse:
+		return r.ResponseCode, r.ResponseDescription
+	case *ReversalAcknowledgement:
+		return r.ResponseCode, r.ResponseDescription
+	}
+	return "", ""
+}
+
+// ResponseCodeAccepted reports whether the ResponseCode means that Daraja accepted the request.
+// Some APIs omit ResponseCode on success, others send zeros, e.g. "00000000".
+func ResponseCodeAccepted(code string) bool {
+	return strings.Trim(code, "0") == ""
+}
+
+func checkResponseCode(api API, response interface{}) error {
+	code, desc := ResponseCodeOf(response)
+	if ResponseCodeAccepted(code) {
+		return nil
+	}
+	return &ResponseCodeError{
+		API:                 api,
+		ResponseCode:        code,
+		ResponseDescription: desc,
+		Response:            response,
+	}
+}
+
+func truncate(b []byte, n int) string {
+	if len(b) <= n {
+		return string(b)
+	}
+	return string(b[:n]) + "..."
+}
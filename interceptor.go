@@ -0,0 +1,94 @@
+package mpesa
+
+import (
+	"net/http"
+	"time"
+)
+
+// Exchange is a single HTTP exchange with Daraja, passed to interceptors.
+// Retried requests produce a new Exchange for every attempt.
+type Exchange struct {
+	API API
+	// Shortcode on behalf of which the request is made, if known.
+	ShortCode string
+	// Number of the attempt, starting from 1.
+	Attempt int
+
+	// Request model passed to the Service method, nil for OAuth requests.
+	Input interface{}
+	// HTTP request to send. Interceptors may modify it or replace it, e.g. with one with another context.
+	Request     *http.Request
+	RequestBody []byte
+
+	// Fields below are set after the response is received.
+
+	// HTTP response with already consumed body. It is nil when the request was not sent.
+	Response     *http.Response
+	ResponseBody []byte
+	// Decoded response: the response model or APIError.
+	Output  interface{}
+	Latency time.Duration
+	// Error of the attempt.
+	Err error
+}
+
+// Interceptor observes and modifies requests to Daraja, including OAuth requests.
+type Interceptor interface {
+	// BeforeSend is called before the request is sent.
+	// Returned error aborts the call: the request is not sent, and AfterReceive hooks of interceptors
+	// which were already called are called with the error in Exchange.Err.
+	BeforeSend(ex *Exchange) error
+	// AfterReceive is called after the response is received and decoded or the request failed.
+	// Returned error fails the call, the rest of AfterReceive hooks are called anyway.
+	AfterReceive(ex *Exchange) error
+}
+
+// InterceptorFuncs is an adapter to use ordinary functions as Interceptor. Nil functions are skipped.
+type InterceptorFuncs struct {
+	Before func(ex *Exchange) error
+	After  func(ex *Exchange) error
+}
+
+func (f InterceptorFuncs) BeforeSend(ex *Exchange) error {
+	if f.Before == nil {
+		return nil
+	}
+	return f.Before(ex)
+}
+
+func (f InterceptorFuncs) AfterReceive(ex *Exchange) error {
+	if f.After == nil {
+		return nil
+	}
+	return f.After(ex)
+}
+
+// WithInterceptor appends interceptors to the chain of the Service.
+// BeforeSend hooks are called in the order of adding, AfterReceive hooks in reverse order.
+func WithInterceptor(interceptors ...Interceptor) Option {
+	return func(s *Service) {
+		s.interceptors = append(s.interceptors, interceptors...)
+	}
+}
+
+// beforeSend calls BeforeSend hooks until one fails and returns the number of succeeded hooks.
+func (s *Service) beforeSend(ex *Exchange) (int, error) {
+	for n, i := range s.interceptors {
+		if err := i.BeforeSend(ex); err != nil {
+			return n, err
+		}
+	}
+	return len(s.interceptors), nil
+}
+
+// afterReceive calls AfterReceive hooks of the first n interceptors in reverse order,
+// so every interceptor which saw the request sees its end. It returns the first error of hooks.
+func (s *Service) afterReceive(ex *Exchange, n int) error {
+	var first error
+	for i := n - 1; i >= 0; i-- {
+		if err := s.interceptors[i].AfterReceive(ex); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
@@ -0,0 +1,177 @@
+package mpesa
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// LogLevel sets how much of every exchange with Daraja is logged.
+type LogLevel int
+
+const (
+	// Exchanges are not logged.
+	LogOff LogLevel = iota
+	// API, status, latency and error of every exchange are logged. It is the default level.
+	LogSummary
+	// Headers and bodies are logged in addition to the summary. Secrets are always redacted.
+	LogFull
+)
+
+// WithLogLevel sets the level of exchange logging.
+func WithLogLevel(level LogLevel) Option {
+	return func(s *Service) {
+		s.logLevel = level
+	}
+}
+
+// WithMSISDNMasking enables masking of phone numbers in logged bodies.
+func WithMSISDNMasking(mask bool) Option {
+	return func(s *Service) {
+		s.maskMSISDN = mask
+	}
+}
+
+const redacted = "[REDACTED]"
+
+// secretFields are JSON fields whose values never get to logs. Names are lowercased.
+var secretFields = map[string]bool{
+	"securitycredential": true,
+	"password":           true,
+	"passkey":            true,
+	"access_token":       true,
+}
+
+var msisdnRe = regexp.MustCompile(`^\+?254\d{9}$`)
+
+// logInterceptor logs every exchange after it has been completed.
+type logInterceptor struct {
+	logger     *slog.Logger
+	level      LogLevel
+	maskMSISDN bool
+}
+
+func (l logInterceptor) BeforeSend(*Exchange) error {
+	return nil
+}
+
+func (l logInterceptor) AfterReceive(ex *Exchange) error {
+	ctx := ex.Request.Context()
+	level := slog.LevelInfo
+	if ex.Err != nil {
+		level = slog.LevelWarn
+	}
+	if !l.logger.Enabled(ctx, level) {
+		return nil
+	}
+	attrs := []slog.Attr{
+		slog.String("api", string(ex.API)),
+		slog.Int("attempt", ex.Attempt),
+		slog.String("method", ex.Request.Method),
+		slog.String("path", ex.Request.URL.Path),
+		slog.Duration("latency", ex.Latency),
+	}
+	if ex.ShortCode != "" {
+		attrs = append(attrs, slog.String("shortcode", ex.ShortCode))
+	}
+	if ex.Response != nil {
+		attrs = append(attrs, slog.Int("status", ex.Response.StatusCode))
+	}
+	if ex.Err != nil {
+		attrs = append(attrs, slog.String("error", ex.Err.Error()))
+	}
+	if l.level >= LogFull {
+		attrs = append(attrs,
+			slog.Any("request_headers", redactHeader(ex.Request.Header)),
+			slog.String("request_body", l.redactBody(ex.RequestBody)),
+			slog.String("response_body", l.redactBody(ex.ResponseBody)),
+		)
+		if ex.Response != nil {
+			attrs = append(attrs, slog.Any("response_headers", redactHeader(ex.Response.Header)))
+		}
+	}
+	l.logger.LogAttrs(ctx, level, "mpesa: exchange", attrs...)
+	return nil
+}
+
+// redactHeader returns the copy of h with credentials hidden.
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, key := range []string{authHeader, "Proxy-Authorization"} {
+		for i, v := range h[key] {
+			scheme, _, found := strings.Cut(v, " ")
+			if found {
+				h[key][i] = scheme + " " + redacted
+			} else {
+				h[key][i] = redacted
+			}
+		}
+	}
+	return h
+}
+
+// redactBody hides secrets and optionally phone numbers in the JSON body.
+// Bodies which are not JSON are replaced completely, because they can not be inspected.
+func (l logInterceptor) redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return redacted
+	}
+	data, err := json.Marshal(l.redactValue("", v))
+	if err != nil {
+		return redacted
+	}
+	return string(data)
+}
+
+func (l logInterceptor) redactValue(key string, v interface{}) interface{} {
+	if secretFields[strings.ToLower(key)] {
+		return redacted
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// Callback metadata is a list of {"Name": ..., "Value": ...} or {"Key": ..., "Value": ...} pairs.
+		name, ok := v["Name"].(string)
+		if !ok {
+			name, _ = v["Key"].(string)
+		}
+		for k, item := range v {
+			if k == "Value" && name != "" {
+				v[k] = l.redactValue(name, item)
+			} else {
+				v[k] = l.redactValue(k, item)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = l.redactValue(key, item)
+		}
+		return v
+	case string:
+		if l.maskMSISDN && msisdnRe.MatchString(v) {
+			return maskMSISDN(v)
+		}
+	case json.Number:
+		if l.maskMSISDN && msisdnRe.MatchString(v.String()) {
+			return maskMSISDN(v.String())
+		}
+	}
+	return v
+}
+
+// maskMSISDN hides all digits of the phone number except the country code and the last three.
+func maskMSISDN(msisdn string) string {
+	if len(msisdn) < 12 {
+		return strings.Repeat("*", len(msisdn))
+	}
+	return msisdn[:len(msisdn)-9] + strings.Repeat("*", 6) + msisdn[len(msisdn)-3:]
+}
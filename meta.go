@@ -0,0 +1,49 @@
+package mpesa
+
+import (
+	"net/http"
+	"time"
+)
+
+// ResponseMeta is the raw data of the last response of the call,
+// which Safaricom support usually asks for.
+type ResponseMeta struct {
+	// HTTP status code, zero if the response was not received.
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	// Latency of the last attempt.
+	Latency time.Duration
+	// Number of attempts made.
+	Attempts int
+	// Daraja request ID, it is sent in error responses.
+	RequestID string
+}
+
+// WithResponseMeta makes the call fill meta with the data of the last response,
+// both for successful and failed calls. Meta is reset at the start of the call,
+// so it is empty if the call fails before sending the request, e.g. because of validation or rate limits.
+func WithResponseMeta(meta *ResponseMeta) CallOption {
+	return func(o *callOptions) {
+		// Options are applied before the call does anything else.
+		if meta != nil {
+			*meta = ResponseMeta{}
+		}
+		o.meta = meta
+	}
+}
+
+func (m *ResponseMeta) fill(ex *Exchange) {
+	*m = ResponseMeta{
+		Body:     ex.ResponseBody,
+		Latency:  ex.Latency,
+		Attempts: ex.Attempt,
+	}
+	if ex.Response != nil {
+		m.StatusCode = ex.Response.StatusCode
+		m.Header = ex.Response.Header
+	}
+	if apiErr, ok := ex.Output.(APIError); ok {
+		m.RequestID = sp(apiErr.RequestId)
+	}
+}
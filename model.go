@@ -4,6 +4,7 @@ package mpesa
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 )
 
 //go:generate easyjson
@@ -16,14 +17,23 @@ type authResponse struct {
 	ExpiresIn string `json:"expires_in"`
 }
 
+// APIError is an error response of Daraja or any other unsuccessful HTTP response.
 //easyjson:json
 type APIError struct {
 	RequestId    *string `json:"requestId"`
 	ErrorCode    *string `json:"errorCode"`
 	ErrorMessage *string `json:"errorMessage"`
+
+	// HTTP status code, headers and raw body of the response.
+	StatusCode int         `json:"-"`
+	Header     http.Header `json:"-"`
+	Body       []byte      `json:"-"`
 }
 
 func (r APIError) Error() string {
+	if r.ErrorCode == nil && r.ErrorMessage == nil && r.StatusCode != 0 {
+		return fmt.Sprintf("%d %s: %s", r.StatusCode, http.StatusText(r.StatusCode), truncate(r.Body, 200))
+	}
 	return fmt.Sprintf("%s - %s", sp(r.ErrorCode), sp(r.ErrorMessage))
 }
 
@@ -52,9 +62,9 @@ type C2B struct {
 	// Unique command for each transaction type. For C2B dafult
 	// CustomerPayBillOnline
 	// CustomerBuyGoodsOnline
-	CommandID string
+	CommandID CommandID
 	// The amount being transacted
-	Amount string
+	Amount Amount
 	// Phone number (msisdn) initiating the transaction
 	Msisdn string
 	// Bill Reference Number (Optional)
@@ -76,9 +86,9 @@ type B2C struct {
 	// SalaryPayment
 	// BusinessPayment
 	// PromotionPayment
-	CommandID string
+	CommandID CommandID
 	// The amount been transacted
-	Amount string
+	Amount Amount
 	// Organization /MSISDN sending the transaction
 	// Shortcode (6 digits)
 	// MSISDN (12 digits)
@@ -97,6 +107,9 @@ type B2C struct {
 	// Optional Parameter
 	// Up to 100
 	Occasion string `json:",omitempty"`
+	// Unique identifier of the request on the caller side, which can be used to query the transaction status.
+	// It is set from the idempotency key if it is empty.
+	OriginatorConversationID string `json:",omitempty"`
 }
 
 //easyjson:json
@@ -112,25 +125,27 @@ type B2CCallback struct {
 		ConversationID           string
 		TransactionID            string
 		ResultParameters         struct {
-			ResultParameter []struct {
-				Key   string
-				Value json.RawMessage
-			}
+			ResultParameter ResultParameters
+		}
+		ReferenceData struct {
+			ReferenceItem ResultParameters
 		}
-		ReferenceData json.RawMessage
 	}
 }
 
 //easyjson:json
 type TransactionStatus struct {
 	// Takes only 'TransactionStatusQuery' command id
-	CommandID string
+	CommandID CommandID
 	// Organization/MSISDN receiving the transaction
 	// -Shortcode (6 digits)
 	// -MSISDN (12 Digits)
 	PartyA string
 	// Type of organization receiving the transaction
-	IdentifierType string
+	// 1 - MSISDN
+	// 2 - Till Number
+	// 4 - Organization short code
+	IdentifierType IdentifierType
 	// Comments that are sent along with the transaction
 	// Up to 100
 	Remarks string
@@ -148,12 +163,18 @@ type TransactionStatus struct {
 	ResultURL string
 	// Unique identifier to identify a transaction on M-Pesa
 	TransactionID string
-	Occasion      string `json:",omitempty"`
+	// OriginatorConversationID of the request, used to identify the transaction instead of TransactionID.
+	OriginalConversationID string `json:",omitempty"`
+	Occasion               string `json:",omitempty"`
 }
 
 //easyjson:json
 type TransactionStatusResponse GenericResponse
 
+// TransactionStatusCallback is the result of the transaction status query sent to ResultURL.
+//easyjson:json
+type TransactionStatusCallback B2CCallback
+
 // https://developer.safaricom.co.ke/lipa-na-m-pesa-online/apis/post/stkpush/v1/processrequest
 //easyjson:json
 type Payment struct {
@@ -169,10 +190,10 @@ type Payment struct {
 	Timestamp string
 	// This is the transaction type that is used to identify the transaction when sending the request to M-Pesa.
 	// The transaction type for M-Pesa Express is "CustomerPayBillOnline"
-	TransactionType string
+	TransactionType CommandID
 	// This is the Amount transacted normally a numeric value. Money that customer pays to the Shorcode.
 	// Only whole numbers are supported.
-	Amount string
+	Amount Amount
 	// The phone number sending money.
 	// The parameter expected is a Valid Safaricom Mobile Number that is M-Pesa registered in the format 2547XXXXXXXX
 	PartyA string
@@ -246,7 +267,7 @@ type C2BRegisterURL struct {
 	ShortCode string
 	// Default response type for timeout. In case a transaction times out,
 	// Mpesa will by default Complete or Cancel the transaction.
-	ResponseType string
+	ResponseType ResponseType
 	// Confirmation URL for the client.
 	ConfirmationURL string
 	// Validation URL for the client.
@@ -259,12 +280,12 @@ type C2BRegisterURLResponse GenericResponse
 //easyjson:json
 type Reversal struct {
 	// Takes only 'TransactionReversal' Command id
-	CommandID string
+	CommandID CommandID
 	// Organization receiving the transaction (shortcode)
 	ReceiverParty string
 	// Type of organization receiving the transaction
 	// Organization Identifier on M-Pesa
-	ReceiverIdentifierType string
+	ReceiverIdentifierType IdentifierType
 	// Comments that are sent along with the transaction.
 	// Up to 100 characters.
 	Remarks string
@@ -277,6 +298,9 @@ type Reversal struct {
 	// The path that stores information of time out transaction
 	// https://ip or domain:port/path
 	QueueTimeOutURL string
+	// The path that receives the result of the transaction
+	// https://ip or domain:port/path
+	ResultURL string
 	// Organization Receiving the funds // WTF??
 	TransactionID string
 	// Optional Parameter
@@ -284,6 +308,12 @@ type Reversal struct {
 	Occasion string
 }
 
+// ReversalAcknowledgement is the synchronous response to the reversal request.
+// The result of the reversal is sent to ResultURL as ReversalResponse.
+//easyjson:json
+type ReversalAcknowledgement GenericResponse
+
+// ReversalResponse is the result of the reversal sent to ResultURL.
 //easyjson:json
 type ReversalResponse struct {
 	Result struct {
@@ -293,12 +323,18 @@ type ReversalResponse struct {
 		OriginatorConversationID string
 		ConversationID           string
 		TransactionID            string
-		ReferenceData            json.RawMessage
+		ResultParameters         struct {
+			ResultParameter ResultParameters
+		}
+		ReferenceData struct {
+			ReferenceItem ResultParameters
+		}
 	}
 }
 
+// C2BValidationRequest is sent by M-Pesa to the validation URL before completing the C2B payment.
 //easyjson:json
-type C2BValidationResponse struct {
+type C2BValidationRequest struct {
 	TransactionType string
 	TransID         string
 	// example: "20170727104247"
@@ -316,8 +352,9 @@ type C2BValidationResponse struct {
 	LastName          string
 }
 
+// C2BConfirmationRequest is sent by M-Pesa to the confirmation URL after completing the C2B payment.
 //easyjson:json
-type C2BConformationResponse struct {
+type C2BConfirmationRequest struct {
 	TransactionType string
 	TransID         string
 	// example: "20170727104247"
@@ -335,6 +372,22 @@ type C2BConformationResponse struct {
 	LastName          string
 }
 
+// Deprecated: use C2BValidationRequest.
+type C2BValidationResponse = C2BValidationRequest
+
+// Deprecated: use C2BConfirmationRequest.
+type C2BConformationResponse = C2BConfirmationRequest
+
+// C2BReply is the reply to the validation or confirmation request of M-Pesa.
+//easyjson:json
+type C2BReply struct {
+	// "0" to accept the payment or one of rejection codes, e.g. "C2B00011".
+	ResultCode C2BResultCode
+	ResultDesc string
+	// Optional transaction ID of the third party system, it is sent back in the confirmation request.
+	ThirdPartyTransID string `json:",omitempty"`
+}
+
 /*
 type BalanceInquiry struct {
 	Initiator          string
@@ -207,186 +207,7 @@ func (v *TransactionStatusResponse) UnmarshalJSON(data []byte) error {
 func (v *TransactionStatusResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo1(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo2(in *jlexer.Lexer, out *TransactionStatus) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "CommandID":
-			out.CommandID = string(in.String())
-		case "PartyA":
-			out.PartyA = string(in.String())
-		case "IdentifierType":
-			out.IdentifierType = string(in.String())
-		case "Remarks":
-			out.Remarks = string(in.String())
-		case "Initiator":
-			out.Initiator = string(in.String())
-		case "SecurityCredential":
-			out.SecurityCredential = string(in.String())
-		case "QueueTimeOutURL":
-			out.QueueTimeOutURL = string(in.String())
-		case "ResultURL":
-			out.ResultURL = string(in.String())
-		case "TransactionID":
-			out.TransactionID = string(in.String())
-		case "Occasion":
-			out.Occasion = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo2(out *jwriter.Writer, in TransactionStatus) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"CommandID\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.CommandID))
-	}
-	{
-		const prefix string = ",\"PartyA\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.PartyA))
-	}
-	{
-		const prefix string = ",\"IdentifierType\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.IdentifierType))
-	}
-	{
-		const prefix string = ",\"Remarks\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Remarks))
-	}
-	{
-		const prefix string = ",\"Initiator\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Initiator))
-	}
-	{
-		const prefix string = ",\"SecurityCredential\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.SecurityCredential))
-	}
-	{
-		const prefix string = ",\"QueueTimeOutURL\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.QueueTimeOutURL))
-	}
-	{
-		const prefix string = ",\"ResultURL\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.ResultURL))
-	}
-	{
-		const prefix string = ",\"TransactionID\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.TransactionID))
-	}
-	if in.Occasion != "" {
-		const prefix string = ",\"Occasion\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Occasion))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v TransactionStatus) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo2(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TransactionStatus) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo2(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *TransactionStatus) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo2(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TransactionStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo2(l, v)
-}
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo3(in *jlexer.Lexer, out *ReversalResponse) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo2(in *jlexer.Lexer, out *TransactionStatusCallback) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -417,7 +238,7 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo3(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo3(out *jwriter.Writer, in ReversalResponse) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo2(out *jwriter.Writer, in TransactionStatusCallback) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -435,27 +256,27 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo3(out *jwriter.Writer, in
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReversalResponse) MarshalJSON() ([]byte, error) {
+func (v TransactionStatusCallback) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo3(&w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReversalResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo3(w, v)
+func (v TransactionStatusCallback) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReversalResponse) UnmarshalJSON(data []byte) error {
+func (v *TransactionStatusCallback) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo3(&r, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReversalResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo3(l, v)
+func (v *TransactionStatusCallback) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo2(l, v)
 }
 func easyjsonC80ae7adDecode(in *jlexer.Lexer, out *struct {
 	ResultType               int
@@ -464,7 +285,8 @@ func easyjsonC80ae7adDecode(in *jlexer.Lexer, out *struct {
 	OriginatorConversationID string
 	ConversationID           string
 	TransactionID            string
-	ReferenceData            json.RawMessage
+	ResultParameters         struct{ ResultParameter ResultParameters }
+	ReferenceData            struct{ ReferenceItem ResultParameters }
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -496,10 +318,10 @@ func easyjsonC80ae7adDecode(in *jlexer.Lexer, out *struct {
 			out.ConversationID = string(in.String())
 		case "TransactionID":
 			out.TransactionID = string(in.String())
+		case "ResultParameters":
+			easyjsonC80ae7adDecode1(in, &out.ResultParameters)
 		case "ReferenceData":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ReferenceData).UnmarshalJSON(data))
-			}
+			easyjsonC80ae7adDecode2(in, &out.ReferenceData)
 		default:
 			in.SkipRecursive()
 		}
@@ -517,7 +339,8 @@ func easyjsonC80ae7adEncode(out *jwriter.Writer, in struct {
 	OriginatorConversationID string
 	ConversationID           string
 	TransactionID            string
-	ReferenceData            json.RawMessage
+	ResultParameters         struct{ ResultParameter ResultParameters }
+	ReferenceData            struct{ ReferenceItem ResultParameters }
 }) {
 	out.RawByte('{')
 	first := true
@@ -582,6 +405,16 @@ func easyjsonC80ae7adEncode(out *jwriter.Writer, in struct {
 		}
 		out.String(string(in.TransactionID))
 	}
+	{
+		const prefix string = ",\"ResultParameters\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonC80ae7adEncode1(out, in.ResultParameters)
+	}
 	{
 		const prefix string = ",\"ReferenceData\":"
 		if first {
@@ -590,11 +423,11 @@ func easyjsonC80ae7adEncode(out *jwriter.Writer, in struct {
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((in.ReferenceData).MarshalJSON())
+		easyjsonC80ae7adEncode2(out, in.ReferenceData)
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo4(in *jlexer.Lexer, out *Reversal) {
+func easyjsonC80ae7adDecode2(in *jlexer.Lexer, out *struct{ ReferenceItem ResultParameters }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -613,24 +446,8 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo4(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "CommandID":
-			out.CommandID = string(in.String())
-		case "ReceiverParty":
-			out.ReceiverParty = string(in.String())
-		case "ReceiverIdentifierType":
-			out.ReceiverIdentifierType = string(in.String())
-		case "Remarks":
-			out.Remarks = string(in.String())
-		case "Initiator":
-			out.Initiator = string(in.String())
-		case "SecurityCredential":
-			out.SecurityCredential = string(in.String())
-		case "QueueTimeOutURL":
-			out.QueueTimeOutURL = string(in.String())
-		case "TransactionID":
-			out.TransactionID = string(in.String())
-		case "Occasion":
-			out.Occasion = string(in.String())
+		case "ReferenceItem":
+			(out.ReferenceItem).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -641,39 +458,153 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo4(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo4(out *jwriter.Writer, in Reversal) {
+func easyjsonC80ae7adEncode2(out *jwriter.Writer, in struct{ ReferenceItem ResultParameters }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"CommandID\":"
+		const prefix string = ",\"ReferenceItem\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.CommandID))
+		(in.ReferenceItem).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+func easyjsonC80ae7adDecode1(in *jlexer.Lexer, out *struct{ ResultParameter ResultParameters }) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ResultParameter":
+			(out.ResultParameter).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonC80ae7adEncode1(out *jwriter.Writer, in struct{ ResultParameter ResultParameters }) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"ReceiverParty\":"
+		const prefix string = ",\"ResultParameter\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ReceiverParty))
+		(in.ResultParameter).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"ReceiverIdentifierType\":"
-		if first {
+	out.RawByte('}')
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo3(in *jlexer.Lexer, out *TransactionStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "CommandID":
+			out.CommandID = CommandID(in.String())
+		case "PartyA":
+			out.PartyA = string(in.String())
+		case "IdentifierType":
+			(out.IdentifierType).UnmarshalEasyJSON(in)
+		case "Remarks":
+			out.Remarks = string(in.String())
+		case "Initiator":
+			out.Initiator = string(in.String())
+		case "SecurityCredential":
+			out.SecurityCredential = string(in.String())
+		case "QueueTimeOutURL":
+			out.QueueTimeOutURL = string(in.String())
+		case "ResultURL":
+			out.ResultURL = string(in.String())
+		case "TransactionID":
+			out.TransactionID = string(in.String())
+		case "OriginalConversationID":
+			out.OriginalConversationID = string(in.String())
+		case "Occasion":
+			out.Occasion = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo3(out *jwriter.Writer, in TransactionStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"CommandID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CommandID))
+	}
+	{
+		const prefix string = ",\"PartyA\":"
+		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ReceiverIdentifierType))
+		out.String(string(in.PartyA))
+	}
+	{
+		const prefix string = ",\"IdentifierType\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(in.IdentifierType).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"Remarks\":"
@@ -715,6 +646,16 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo4(out *jwriter.Writer, in
 		}
 		out.String(string(in.QueueTimeOutURL))
 	}
+	{
+		const prefix string = ",\"ResultURL\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ResultURL))
+	}
 	{
 		const prefix string = ",\"TransactionID\":"
 		if first {
@@ -725,7 +666,17 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo4(out *jwriter.Writer, in
 		}
 		out.String(string(in.TransactionID))
 	}
-	{
+	if in.OriginalConversationID != "" {
+		const prefix string = ",\"OriginalConversationID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OriginalConversationID))
+	}
+	if in.Occasion != "" {
 		const prefix string = ",\"Occasion\":"
 		if first {
 			first = false
@@ -739,29 +690,100 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo4(out *jwriter.Writer, in
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Reversal) MarshalJSON() ([]byte, error) {
+func (v TransactionStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TransactionStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TransactionStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TransactionStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo3(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo4(in *jlexer.Lexer, out *ReversalResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Result":
+			easyjsonC80ae7adDecode(in, &out.Result)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo4(out *jwriter.Writer, in ReversalResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Result\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		easyjsonC80ae7adEncode(out, in.Result)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReversalResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Reversal) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ReversalResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Reversal) UnmarshalJSON(data []byte) error {
+func (v *ReversalResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Reversal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ReversalResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo4(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo5(in *jlexer.Lexer, out *PaymentResponse) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo5(in *jlexer.Lexer, out *ReversalAcknowledgement) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -780,16 +802,14 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo5(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "MerchantRequestID":
-			out.MerchantRequestID = string(in.String())
-		case "CheckoutRequestID":
-			out.CheckoutRequestID = string(in.String())
+		case "OriginatorConversationID":
+			out.OriginatorConversationID = string(in.String())
+		case "ConversationID":
+			out.ConversationID = string(in.String())
 		case "ResponseDescription":
 			out.ResponseDescription = string(in.String())
 		case "ResponseCode":
 			out.ResponseCode = string(in.String())
-		case "CustomerMessage":
-			out.CustomerMessage = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -800,31 +820,31 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo5(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo5(out *jwriter.Writer, in PaymentResponse) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo5(out *jwriter.Writer, in ReversalAcknowledgement) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"MerchantRequestID\":"
+	if in.OriginatorConversationID != "" {
+		const prefix string = ",\"OriginatorConversationID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.MerchantRequestID))
+		out.String(string(in.OriginatorConversationID))
 	}
-	{
-		const prefix string = ",\"CheckoutRequestID\":"
+	if in.ConversationID != "" {
+		const prefix string = ",\"ConversationID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.CheckoutRequestID))
+		out.String(string(in.ConversationID))
 	}
-	{
+	if in.ResponseDescription != "" {
 		const prefix string = ",\"ResponseDescription\":"
 		if first {
 			first = false
@@ -834,7 +854,7 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo5(out *jwriter.Writer, in
 		}
 		out.String(string(in.ResponseDescription))
 	}
-	{
+	if in.ResponseCode != "" {
 		const prefix string = ",\"ResponseCode\":"
 		if first {
 			first = false
@@ -844,43 +864,33 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo5(out *jwriter.Writer, in
 		}
 		out.String(string(in.ResponseCode))
 	}
-	{
-		const prefix string = ",\"CustomerMessage\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.CustomerMessage))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PaymentResponse) MarshalJSON() ([]byte, error) {
+func (v ReversalAcknowledgement) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ReversalAcknowledgement) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentResponse) UnmarshalJSON(data []byte) error {
+func (v *ReversalAcknowledgement) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ReversalAcknowledgement) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo5(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(in *jlexer.Lexer, out *PaymentCallback) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(in *jlexer.Lexer, out *Reversal) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -899,8 +909,26 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "Body":
-			easyjsonC80ae7adDecode1(in, &out.Body)
+		case "CommandID":
+			out.CommandID = CommandID(in.String())
+		case "ReceiverParty":
+			out.ReceiverParty = string(in.String())
+		case "ReceiverIdentifierType":
+			(out.ReceiverIdentifierType).UnmarshalEasyJSON(in)
+		case "Remarks":
+			out.Remarks = string(in.String())
+		case "Initiator":
+			out.Initiator = string(in.String())
+		case "SecurityCredential":
+			out.SecurityCredential = string(in.String())
+		case "QueueTimeOutURL":
+			out.QueueTimeOutURL = string(in.String())
+		case "ResultURL":
+			out.ResultURL = string(in.String())
+		case "TransactionID":
+			out.TransactionID = string(in.String())
+		case "Occasion":
+			out.Occasion = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -911,131 +939,137 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo6(out *jwriter.Writer, in PaymentCallback) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo6(out *jwriter.Writer, in Reversal) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Body\":"
+		const prefix string = ",\"CommandID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjsonC80ae7adEncode1(out, in.Body)
+		out.String(string(in.CommandID))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v PaymentCallback) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo6(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PaymentCallback) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo6(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *PaymentCallback) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PaymentCallback) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(l, v)
-}
-func easyjsonC80ae7adDecode1(in *jlexer.Lexer, out *struct {
-	STKCallback struct {
-		MerchantRequestID string
-		CheckoutRequestID string
-		ResultCode        int
-		ResultDesc        string
-		CallbackMetadata  struct {
-			Item []struct {
-				Name  string
-				Value json.RawMessage `json:",omitempty"`
-			}
-		} `json:",omitempty"`
-	} `json:"stkCallback"`
-}) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
+	{
+		const prefix string = ",\"ReceiverParty\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		in.Skip()
-		return
+		out.String(string(in.ReceiverParty))
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
+	{
+		const prefix string = ",\"ReceiverIdentifierType\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		switch key {
-		case "stkCallback":
-			easyjsonC80ae7adDecode2(in, &out.STKCallback)
-		default:
-			in.SkipRecursive()
+		(in.ReceiverIdentifierType).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"Remarks\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		in.WantComma()
+		out.String(string(in.Remarks))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"Initiator\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Initiator))
 	}
-}
-func easyjsonC80ae7adEncode1(out *jwriter.Writer, in struct {
-	STKCallback struct {
-		MerchantRequestID string
-		CheckoutRequestID string
-		ResultCode        int
-		ResultDesc        string
-		CallbackMetadata  struct {
-			Item []struct {
-				Name  string
-				Value json.RawMessage `json:",omitempty"`
-			}
-		} `json:",omitempty"`
-	} `json:"stkCallback"`
-}) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"stkCallback\":"
+		const prefix string = ",\"SecurityCredential\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.SecurityCredential))
+	}
+	{
+		const prefix string = ",\"QueueTimeOutURL\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.QueueTimeOutURL))
+	}
+	{
+		const prefix string = ",\"ResultURL\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ResultURL))
+	}
+	{
+		const prefix string = ",\"TransactionID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TransactionID))
+	}
+	{
+		const prefix string = ",\"Occasion\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjsonC80ae7adEncode2(out, in.STKCallback)
+		out.String(string(in.Occasion))
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecode2(in *jlexer.Lexer, out *struct {
-	MerchantRequestID string
-	CheckoutRequestID string
-	ResultCode        int
-	ResultDesc        string
-	CallbackMetadata  struct {
-		Item []struct {
-			Name  string
-			Value json.RawMessage `json:",omitempty"`
-		}
-	} `json:",omitempty"`
-}) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v Reversal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Reversal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Reversal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Reversal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo6(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(in *jlexer.Lexer, out *PaymentResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1058,12 +1092,12 @@ func easyjsonC80ae7adDecode2(in *jlexer.Lexer, out *struct {
 			out.MerchantRequestID = string(in.String())
 		case "CheckoutRequestID":
 			out.CheckoutRequestID = string(in.String())
-		case "ResultCode":
-			out.ResultCode = int(in.Int())
-		case "ResultDesc":
-			out.ResultDesc = string(in.String())
-		case "CallbackMetadata":
-			easyjsonC80ae7adDecode3(in, &out.CallbackMetadata)
+		case "ResponseDescription":
+			out.ResponseDescription = string(in.String())
+		case "ResponseCode":
+			out.ResponseCode = string(in.String())
+		case "CustomerMessage":
+			out.CustomerMessage = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1074,18 +1108,7 @@ func easyjsonC80ae7adDecode2(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncode2(out *jwriter.Writer, in struct {
-	MerchantRequestID string
-	CheckoutRequestID string
-	ResultCode        int
-	ResultDesc        string
-	CallbackMetadata  struct {
-		Item []struct {
-			Name  string
-			Value json.RawMessage `json:",omitempty"`
-		}
-	} `json:",omitempty"`
-}) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo7(out *jwriter.Writer, in PaymentResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1110,43 +1133,62 @@ func easyjsonC80ae7adEncode2(out *jwriter.Writer, in struct {
 		out.String(string(in.CheckoutRequestID))
 	}
 	{
-		const prefix string = ",\"ResultCode\":"
+		const prefix string = ",\"ResponseDescription\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Int(int(in.ResultCode))
+		out.String(string(in.ResponseDescription))
 	}
 	{
-		const prefix string = ",\"ResultDesc\":"
+		const prefix string = ",\"ResponseCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResultDesc))
+		out.String(string(in.ResponseCode))
 	}
-	if true {
-		const prefix string = ",\"CallbackMetadata\":"
+	{
+		const prefix string = ",\"CustomerMessage\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjsonC80ae7adEncode3(out, in.CallbackMetadata)
+		out.String(string(in.CustomerMessage))
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecode3(in *jlexer.Lexer, out *struct {
-	Item []struct {
-		Name  string
-		Value json.RawMessage `json:",omitempty"`
-	}
-}) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v PaymentResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PaymentResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PaymentResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PaymentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo8(in *jlexer.Lexer, out *PaymentCallback) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1165,38 +1207,8 @@ func easyjsonC80ae7adDecode3(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "Item":
-			if in.IsNull() {
-				in.Skip()
-				out.Item = nil
-			} else {
-				in.Delim('[')
-				if out.Item == nil {
-					if !in.IsDelim(']') {
-						out.Item = make([]struct {
-							Name  string
-							Value json.RawMessage `json:",omitempty"`
-						}, 0, 1)
-					} else {
-						out.Item = []struct {
-							Name  string
-							Value json.RawMessage `json:",omitempty"`
-						}{}
-					}
-				} else {
-					out.Item = (out.Item)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 struct {
-						Name  string
-						Value json.RawMessage `json:",omitempty"`
-					}
-					easyjsonC80ae7adDecode4(in, &v1)
-					out.Item = append(out.Item, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "Body":
+			easyjsonC80ae7adDecode3(in, &out.Body)
 		default:
 			in.SkipRecursive()
 		}
@@ -1207,41 +1219,59 @@ func easyjsonC80ae7adDecode3(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncode3(out *jwriter.Writer, in struct {
-	Item []struct {
-		Name  string
-		Value json.RawMessage `json:",omitempty"`
-	}
-}) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo8(out *jwriter.Writer, in PaymentCallback) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Item\":"
+		const prefix string = ",\"Body\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		if in.Item == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v2, v3 := range in.Item {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				easyjsonC80ae7adEncode4(out, v3)
-			}
-			out.RawByte(']')
-		}
+		easyjsonC80ae7adEncode3(out, in.Body)
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecode4(in *jlexer.Lexer, out *struct {
-	Name  string
-	Value json.RawMessage `json:",omitempty"`
+
+// MarshalJSON supports json.Marshaler interface
+func (v PaymentCallback) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PaymentCallback) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PaymentCallback) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PaymentCallback) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo8(l, v)
+}
+func easyjsonC80ae7adDecode3(in *jlexer.Lexer, out *struct {
+	STKCallback struct {
+		MerchantRequestID string
+		CheckoutRequestID string
+		ResultCode        int
+		ResultDesc        string
+		CallbackMetadata  struct {
+			Item []struct {
+				Name  string
+				Value json.RawMessage `json:",omitempty"`
+			}
+		} `json:",omitempty"`
+	} `json:"stkCallback"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1261,12 +1291,8 @@ func easyjsonC80ae7adDecode4(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "Name":
-			out.Name = string(in.String())
-		case "Value":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Value).UnmarshalJSON(data))
-			}
+		case "stkCallback":
+			easyjsonC80ae7adDecode4(in, &out.STKCallback)
 		default:
 			in.SkipRecursive()
 		}
@@ -1277,36 +1303,47 @@ func easyjsonC80ae7adDecode4(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncode4(out *jwriter.Writer, in struct {
-	Name  string
-	Value json.RawMessage `json:",omitempty"`
+func easyjsonC80ae7adEncode3(out *jwriter.Writer, in struct {
+	STKCallback struct {
+		MerchantRequestID string
+		CheckoutRequestID string
+		ResultCode        int
+		ResultDesc        string
+		CallbackMetadata  struct {
+			Item []struct {
+				Name  string
+				Value json.RawMessage `json:",omitempty"`
+			}
+		} `json:",omitempty"`
+	} `json:"stkCallback"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Name\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Name))
-	}
-	if len(in.Value) != 0 {
-		const prefix string = ",\"Value\":"
+		const prefix string = ",\"stkCallback\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((in.Value).MarshalJSON())
+		easyjsonC80ae7adEncode4(out, in.STKCallback)
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(in *jlexer.Lexer, out *Payment) {
+func easyjsonC80ae7adDecode4(in *jlexer.Lexer, out *struct {
+	MerchantRequestID string
+	CheckoutRequestID string
+	ResultCode        int
+	ResultDesc        string
+	CallbackMetadata  struct {
+		Item []struct {
+			Name  string
+			Value json.RawMessage `json:",omitempty"`
+		}
+	} `json:",omitempty"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1325,28 +1362,16 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "BusinessShortCode":
-			out.BusinessShortCode = string(in.String())
-		case "Password":
-			out.Password = string(in.String())
-		case "Timestamp":
-			out.Timestamp = string(in.String())
-		case "TransactionType":
-			out.TransactionType = string(in.String())
-		case "Amount":
-			out.Amount = string(in.String())
-		case "PartyA":
-			out.PartyA = string(in.String())
-		case "PartyB":
-			out.PartyB = string(in.String())
-		case "PhoneNumber":
-			out.PhoneNumber = string(in.String())
-		case "CallBackURL":
-			out.CallBackURL = string(in.String())
-		case "AccountReference":
-			out.AccountReference = string(in.String())
-		case "TransactionDesc":
-			out.TransactionDesc = string(in.String())
+		case "MerchantRequestID":
+			out.MerchantRequestID = string(in.String())
+		case "CheckoutRequestID":
+			out.CheckoutRequestID = string(in.String())
+		case "ResultCode":
+			out.ResultCode = int(in.Int())
+		case "ResultDesc":
+			out.ResultDesc = string(in.String())
+		case "CallbackMetadata":
+			easyjsonC80ae7adDecode5(in, &out.CallbackMetadata)
 		default:
 			in.SkipRecursive()
 		}
@@ -1357,254 +1382,175 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo7(out *jwriter.Writer, in Payment) {
+func easyjsonC80ae7adEncode4(out *jwriter.Writer, in struct {
+	MerchantRequestID string
+	CheckoutRequestID string
+	ResultCode        int
+	ResultDesc        string
+	CallbackMetadata  struct {
+		Item []struct {
+			Name  string
+			Value json.RawMessage `json:",omitempty"`
+		}
+	} `json:",omitempty"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"BusinessShortCode\":"
+		const prefix string = ",\"MerchantRequestID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.BusinessShortCode))
+		out.String(string(in.MerchantRequestID))
 	}
 	{
-		const prefix string = ",\"Password\":"
+		const prefix string = ",\"CheckoutRequestID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Password))
+		out.String(string(in.CheckoutRequestID))
 	}
 	{
-		const prefix string = ",\"Timestamp\":"
+		const prefix string = ",\"ResultCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Timestamp))
+		out.Int(int(in.ResultCode))
 	}
 	{
-		const prefix string = ",\"TransactionType\":"
+		const prefix string = ",\"ResultDesc\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.TransactionType))
+		out.String(string(in.ResultDesc))
 	}
-	{
-		const prefix string = ",\"Amount\":"
+	if true {
+		const prefix string = ",\"CallbackMetadata\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Amount))
+		easyjsonC80ae7adEncode5(out, in.CallbackMetadata)
+	}
+	out.RawByte('}')
+}
+func easyjsonC80ae7adDecode5(in *jlexer.Lexer, out *struct {
+	Item []struct {
+		Name  string
+		Value json.RawMessage `json:",omitempty"`
+	}
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Item":
+			if in.IsNull() {
+				in.Skip()
+				out.Item = nil
+			} else {
+				in.Delim('[')
+				if out.Item == nil {
+					if !in.IsDelim(']') {
+						out.Item = make([]struct {
+							Name  string
+							Value json.RawMessage `json:",omitempty"`
+						}, 0, 1)
+					} else {
+						out.Item = []struct {
+							Name  string
+							Value json.RawMessage `json:",omitempty"`
+						}{}
+					}
+				} else {
+					out.Item = (out.Item)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 struct {
+						Name  string
+						Value json.RawMessage `json:",omitempty"`
+					}
+					easyjsonC80ae7adDecode6(in, &v1)
+					out.Item = append(out.Item, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC80ae7adEncode5(out *jwriter.Writer, in struct {
+	Item []struct {
+		Name  string
+		Value json.RawMessage `json:",omitempty"`
 	}
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"PartyA\":"
+		const prefix string = ",\"Item\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.PartyA))
-	}
-	{
-		const prefix string = ",\"PartyB\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.PartyB))
-	}
-	{
-		const prefix string = ",\"PhoneNumber\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.PhoneNumber))
-	}
-	{
-		const prefix string = ",\"CallBackURL\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.CallBackURL))
-	}
-	{
-		const prefix string = ",\"AccountReference\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.AccountReference))
-	}
-	{
-		const prefix string = ",\"TransactionDesc\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.TransactionDesc))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Payment) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo7(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Payment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo7(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Payment) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Payment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo7(l, v)
-}
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo8(in *jlexer.Lexer, out *GenericResponse) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "OriginatorConversationID":
-			out.OriginatorConversationID = string(in.String())
-		case "ConversationID":
-			out.ConversationID = string(in.String())
-		case "ResponseDescription":
-			out.ResponseDescription = string(in.String())
-		case "ResponseCode":
-			out.ResponseCode = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo8(out *jwriter.Writer, in GenericResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	if in.OriginatorConversationID != "" {
-		const prefix string = ",\"OriginatorConversationID\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.OriginatorConversationID))
-	}
-	if in.ConversationID != "" {
-		const prefix string = ",\"ConversationID\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.ConversationID))
-	}
-	if in.ResponseDescription != "" {
-		const prefix string = ",\"ResponseDescription\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.ResponseDescription))
-	}
-	if in.ResponseCode != "" {
-		const prefix string = ",\"ResponseCode\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
+		if in.Item == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
 		} else {
-			out.RawString(prefix)
+			out.RawByte('[')
+			for v2, v3 := range in.Item {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonC80ae7adEncode6(out, v3)
+			}
+			out.RawByte(']')
 		}
-		out.String(string(in.ResponseCode))
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v GenericResponse) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo8(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GenericResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo8(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *GenericResponse) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo8(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GenericResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo8(l, v)
-}
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo9(in *jlexer.Lexer, out *C2BValidationResponse) {
+func easyjsonC80ae7adDecode6(in *jlexer.Lexer, out *struct {
+	Name  string
+	Value json.RawMessage `json:",omitempty"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1622,204 +1568,53 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo9(in *jlexer.Lexer, out *
 			in.WantComma()
 			continue
 		}
-		switch key {
-		case "TransactionType":
-			out.TransactionType = string(in.String())
-		case "TransID":
-			out.TransID = string(in.String())
-		case "TransTime":
-			out.TransTime = string(in.String())
-		case "TransAmount":
-			out.TransAmount = string(in.String())
-		case "BusinessShortCode":
-			out.BusinessShortCode = string(in.String())
-		case "BillRefNumber":
-			out.BillRefNumber = string(in.String())
-		case "InvoiceNumber":
-			out.InvoiceNumber = string(in.String())
-		case "OrgAccountBalance":
-			out.OrgAccountBalance = string(in.String())
-		case "ThirdPartyTransID":
-			out.ThirdPartyTransID = string(in.String())
-		case "MSISDN":
-			out.MSISDN = string(in.String())
-		case "FirstName":
-			out.FirstName = string(in.String())
-		case "MiddleName":
-			out.MiddleName = string(in.String())
-		case "LastName":
-			out.LastName = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo9(out *jwriter.Writer, in C2BValidationResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"TransactionType\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.TransactionType))
-	}
-	{
-		const prefix string = ",\"TransID\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.TransID))
-	}
-	{
-		const prefix string = ",\"TransTime\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.TransTime))
-	}
-	{
-		const prefix string = ",\"TransAmount\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.TransAmount))
-	}
-	{
-		const prefix string = ",\"BusinessShortCode\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.BusinessShortCode))
-	}
-	{
-		const prefix string = ",\"BillRefNumber\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.BillRefNumber))
-	}
-	{
-		const prefix string = ",\"InvoiceNumber\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.InvoiceNumber))
-	}
-	{
-		const prefix string = ",\"OrgAccountBalance\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.OrgAccountBalance))
-	}
-	{
-		const prefix string = ",\"ThirdPartyTransID\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.ThirdPartyTransID))
-	}
-	{
-		const prefix string = ",\"MSISDN\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.MSISDN))
-	}
-	{
-		const prefix string = ",\"FirstName\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
+		switch key {
+		case "Name":
+			out.Name = string(in.String())
+		case "Value":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Value).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
 		}
-		out.String(string(in.FirstName))
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonC80ae7adEncode6(out *jwriter.Writer, in struct {
+	Name  string
+	Value json.RawMessage `json:",omitempty"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"MiddleName\":"
+		const prefix string = ",\"Name\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.MiddleName))
+		out.String(string(in.Name))
 	}
-	{
-		const prefix string = ",\"LastName\":"
+	if len(in.Value) != 0 {
+		const prefix string = ",\"Value\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.LastName))
+		out.Raw((in.Value).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v C2BValidationResponse) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo9(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v C2BValidationResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo9(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *C2BValidationResponse) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo9(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *C2BValidationResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo9(l, v)
-}
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(in *jlexer.Lexer, out *C2BResponse) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo9(in *jlexer.Lexer, out *Payment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1838,14 +1633,28 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "OriginatorConversationID":
-			out.OriginatorConversationID = string(in.String())
-		case "ConversationID":
-			out.ConversationID = string(in.String())
-		case "ResponseDescription":
-			out.ResponseDescription = string(in.String())
-		case "ResponseCode":
-			out.ResponseCode = string(in.String())
+		case "BusinessShortCode":
+			out.BusinessShortCode = string(in.String())
+		case "Password":
+			out.Password = string(in.String())
+		case "Timestamp":
+			out.Timestamp = string(in.String())
+		case "TransactionType":
+			out.TransactionType = CommandID(in.String())
+		case "Amount":
+			(out.Amount).UnmarshalEasyJSON(in)
+		case "PartyA":
+			out.PartyA = string(in.String())
+		case "PartyB":
+			out.PartyB = string(in.String())
+		case "PhoneNumber":
+			out.PhoneNumber = string(in.String())
+		case "CallBackURL":
+			out.CallBackURL = string(in.String())
+		case "AccountReference":
+			out.AccountReference = string(in.String())
+		case "TransactionDesc":
+			out.TransactionDesc = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1856,184 +1665,147 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo10(out *jwriter.Writer, in C2BResponse) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo9(out *jwriter.Writer, in Payment) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.OriginatorConversationID != "" {
-		const prefix string = ",\"OriginatorConversationID\":"
+	{
+		const prefix string = ",\"BusinessShortCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.OriginatorConversationID))
+		out.String(string(in.BusinessShortCode))
 	}
-	if in.ConversationID != "" {
-		const prefix string = ",\"ConversationID\":"
+	{
+		const prefix string = ",\"Password\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ConversationID))
+		out.String(string(in.Password))
 	}
-	if in.ResponseDescription != "" {
-		const prefix string = ",\"ResponseDescription\":"
+	{
+		const prefix string = ",\"Timestamp\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResponseDescription))
+		out.String(string(in.Timestamp))
 	}
-	if in.ResponseCode != "" {
-		const prefix string = ",\"ResponseCode\":"
+	{
+		const prefix string = ",\"TransactionType\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResponseCode))
+		out.String(string(in.TransactionType))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v C2BResponse) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo10(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v C2BResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo10(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *C2BResponse) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *C2BResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(l, v)
-}
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo11(in *jlexer.Lexer, out *C2BRegisterURLResponse) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
+	{
+		const prefix string = ",\"Amount\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		in.Skip()
-		return
+		(in.Amount).MarshalEasyJSON(out)
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "OriginatorConversationID":
-			out.OriginatorConversationID = string(in.String())
-		case "ConversationID":
-			out.ConversationID = string(in.String())
-		case "ResponseDescription":
-			out.ResponseDescription = string(in.String())
-		case "ResponseCode":
-			out.ResponseCode = string(in.String())
-		default:
-			in.SkipRecursive()
+	{
+		const prefix string = ",\"PartyA\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
-		in.WantComma()
+		out.String(string(in.PartyA))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"PartyB\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PartyB))
 	}
-}
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo11(out *jwriter.Writer, in C2BRegisterURLResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	if in.OriginatorConversationID != "" {
-		const prefix string = ",\"OriginatorConversationID\":"
+	{
+		const prefix string = ",\"PhoneNumber\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.OriginatorConversationID))
+		out.String(string(in.PhoneNumber))
 	}
-	if in.ConversationID != "" {
-		const prefix string = ",\"ConversationID\":"
+	{
+		const prefix string = ",\"CallBackURL\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ConversationID))
+		out.String(string(in.CallBackURL))
 	}
-	if in.ResponseDescription != "" {
-		const prefix string = ",\"ResponseDescription\":"
+	{
+		const prefix string = ",\"AccountReference\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResponseDescription))
+		out.String(string(in.AccountReference))
 	}
-	if in.ResponseCode != "" {
-		const prefix string = ",\"ResponseCode\":"
+	{
+		const prefix string = ",\"TransactionDesc\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResponseCode))
+		out.String(string(in.TransactionDesc))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v C2BRegisterURLResponse) MarshalJSON() ([]byte, error) {
+func (v Payment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo11(&w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v C2BRegisterURLResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo11(w, v)
+func (v Payment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *C2BRegisterURLResponse) UnmarshalJSON(data []byte) error {
+func (v *Payment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo11(&r, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *C2BRegisterURLResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo11(l, v)
+func (v *Payment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo9(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(in *jlexer.Lexer, out *C2BRegisterURL) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(in *jlexer.Lexer, out *GenericResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2052,14 +1824,14 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "ShortCode":
-			out.ShortCode = string(in.String())
-		case "ResponseType":
-			out.ResponseType = string(in.String())
-		case "ConfirmationURL":
-			out.ConfirmationURL = string(in.String())
-		case "ValidationURL":
-			out.ValidationURL = string(in.String())
+		case "OriginatorConversationID":
+			out.OriginatorConversationID = string(in.String())
+		case "ConversationID":
+			out.ConversationID = string(in.String())
+		case "ResponseDescription":
+			out.ResponseDescription = string(in.String())
+		case "ResponseCode":
+			out.ResponseCode = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2070,77 +1842,77 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo12(out *jwriter.Writer, in C2BRegisterURL) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo10(out *jwriter.Writer, in GenericResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"ShortCode\":"
+	if in.OriginatorConversationID != "" {
+		const prefix string = ",\"OriginatorConversationID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ShortCode))
+		out.String(string(in.OriginatorConversationID))
 	}
-	{
-		const prefix string = ",\"ResponseType\":"
+	if in.ConversationID != "" {
+		const prefix string = ",\"ConversationID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResponseType))
+		out.String(string(in.ConversationID))
 	}
-	{
-		const prefix string = ",\"ConfirmationURL\":"
+	if in.ResponseDescription != "" {
+		const prefix string = ",\"ResponseDescription\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ConfirmationURL))
+		out.String(string(in.ResponseDescription))
 	}
-	{
-		const prefix string = ",\"ValidationURL\":"
+	if in.ResponseCode != "" {
+		const prefix string = ",\"ResponseCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ValidationURL))
+		out.String(string(in.ResponseCode))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v C2BRegisterURL) MarshalJSON() ([]byte, error) {
+func (v GenericResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo12(&w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v C2BRegisterURL) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo12(w, v)
+func (v GenericResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *C2BRegisterURL) UnmarshalJSON(data []byte) error {
+func (v *GenericResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(&r, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *C2BRegisterURL) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(l, v)
+func (v *GenericResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo10(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo13(in *jlexer.Lexer, out *C2BConformationResponse) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo11(in *jlexer.Lexer, out *C2BValidationRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2195,7 +1967,7 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo13(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo13(out *jwriter.Writer, in C2BConformationResponse) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo11(out *jwriter.Writer, in C2BValidationRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2333,29 +2105,29 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo13(out *jwriter.Writer, i
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v C2BConformationResponse) MarshalJSON() ([]byte, error) {
+func (v C2BValidationRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo13(&w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v C2BConformationResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo13(w, v)
+func (v C2BValidationRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *C2BConformationResponse) UnmarshalJSON(data []byte) error {
+func (v *C2BValidationRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo13(&r, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *C2BConformationResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo13(l, v)
+func (v *C2BValidationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo11(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo14(in *jlexer.Lexer, out *C2B) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(in *jlexer.Lexer, out *C2BResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2374,16 +2146,14 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo14(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "ShortCode":
-			out.ShortCode = string(in.String())
-		case "CommandID":
-			out.CommandID = string(in.String())
-		case "Amount":
-			out.Amount = string(in.String())
-		case "Msisdn":
-			out.Msisdn = string(in.String())
-		case "BillRefNumber":
-			out.BillRefNumber = string(in.String())
+		case "OriginatorConversationID":
+			out.OriginatorConversationID = string(in.String())
+		case "ConversationID":
+			out.ConversationID = string(in.String())
+		case "ResponseDescription":
+			out.ResponseDescription = string(in.String())
+		case "ResponseCode":
+			out.ResponseCode = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2394,87 +2164,279 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo14(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo14(out *jwriter.Writer, in C2B) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo12(out *jwriter.Writer, in C2BResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"ShortCode\":"
+	if in.OriginatorConversationID != "" {
+		const prefix string = ",\"OriginatorConversationID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ShortCode))
+		out.String(string(in.OriginatorConversationID))
 	}
-	{
-		const prefix string = ",\"CommandID\":"
+	if in.ConversationID != "" {
+		const prefix string = ",\"ConversationID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.CommandID))
+		out.String(string(in.ConversationID))
+	}
+	if in.ResponseDescription != "" {
+		const prefix string = ",\"ResponseDescription\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ResponseDescription))
+	}
+	if in.ResponseCode != "" {
+		const prefix string = ",\"ResponseCode\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ResponseCode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v C2BResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v C2BResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *C2BResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *C2BResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo12(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo13(in *jlexer.Lexer, out *C2BReply) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ResultCode":
+			out.ResultCode = C2BResultCode(in.String())
+		case "ResultDesc":
+			out.ResultDesc = string(in.String())
+		case "ThirdPartyTransID":
+			out.ThirdPartyTransID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo13(out *jwriter.Writer, in C2BReply) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"Amount\":"
+		const prefix string = ",\"ResultCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Amount))
+		out.String(string(in.ResultCode))
 	}
 	{
-		const prefix string = ",\"Msisdn\":"
+		const prefix string = ",\"ResultDesc\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ResultDesc))
+	}
+	if in.ThirdPartyTransID != "" {
+		const prefix string = ",\"ThirdPartyTransID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ThirdPartyTransID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v C2BReply) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v C2BReply) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *C2BReply) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *C2BReply) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo13(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo14(in *jlexer.Lexer, out *C2BRegisterURLResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "OriginatorConversationID":
+			out.OriginatorConversationID = string(in.String())
+		case "ConversationID":
+			out.ConversationID = string(in.String())
+		case "ResponseDescription":
+			out.ResponseDescription = string(in.String())
+		case "ResponseCode":
+			out.ResponseCode = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo14(out *jwriter.Writer, in C2BRegisterURLResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.OriginatorConversationID != "" {
+		const prefix string = ",\"OriginatorConversationID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OriginatorConversationID))
+	}
+	if in.ConversationID != "" {
+		const prefix string = ",\"ConversationID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ConversationID))
+	}
+	if in.ResponseDescription != "" {
+		const prefix string = ",\"ResponseDescription\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Msisdn))
+		out.String(string(in.ResponseDescription))
 	}
-	if in.BillRefNumber != "" {
-		const prefix string = ",\"BillRefNumber\":"
+	if in.ResponseCode != "" {
+		const prefix string = ",\"ResponseCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.BillRefNumber))
+		out.String(string(in.ResponseCode))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v C2B) MarshalJSON() ([]byte, error) {
+func (v C2BRegisterURLResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v C2B) MarshalEasyJSON(w *jwriter.Writer) {
+func (v C2BRegisterURLResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *C2B) UnmarshalJSON(data []byte) error {
+func (v *C2BRegisterURLResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *C2B) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *C2BRegisterURLResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo14(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo15(in *jlexer.Lexer, out *B2CResponse) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo15(in *jlexer.Lexer, out *C2BRegisterURL) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2493,14 +2455,14 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo15(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "OriginatorConversationID":
-			out.OriginatorConversationID = string(in.String())
-		case "ConversationID":
-			out.ConversationID = string(in.String())
-		case "ResponseDescription":
-			out.ResponseDescription = string(in.String())
-		case "ResponseCode":
-			out.ResponseCode = string(in.String())
+		case "ShortCode":
+			out.ShortCode = string(in.String())
+		case "ResponseType":
+			out.ResponseType = ResponseType(in.String())
+		case "ConfirmationURL":
+			out.ConfirmationURL = string(in.String())
+		case "ValidationURL":
+			out.ValidationURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2511,77 +2473,77 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo15(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo15(out *jwriter.Writer, in B2CResponse) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo15(out *jwriter.Writer, in C2BRegisterURL) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.OriginatorConversationID != "" {
-		const prefix string = ",\"OriginatorConversationID\":"
+	{
+		const prefix string = ",\"ShortCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.OriginatorConversationID))
+		out.String(string(in.ShortCode))
 	}
-	if in.ConversationID != "" {
-		const prefix string = ",\"ConversationID\":"
+	{
+		const prefix string = ",\"ResponseType\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ConversationID))
+		out.String(string(in.ResponseType))
 	}
-	if in.ResponseDescription != "" {
-		const prefix string = ",\"ResponseDescription\":"
+	{
+		const prefix string = ",\"ConfirmationURL\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResponseDescription))
+		out.String(string(in.ConfirmationURL))
 	}
-	if in.ResponseCode != "" {
-		const prefix string = ",\"ResponseCode\":"
+	{
+		const prefix string = ",\"ValidationURL\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ResponseCode))
+		out.String(string(in.ValidationURL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v B2CResponse) MarshalJSON() ([]byte, error) {
+func (v C2BRegisterURL) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v B2CResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v C2BRegisterURL) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *B2CResponse) UnmarshalJSON(data []byte) error {
+func (v *C2BRegisterURL) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *B2CResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *C2BRegisterURL) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo15(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo16(in *jlexer.Lexer, out *B2CCallback) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo16(in *jlexer.Lexer, out *C2BConfirmationRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2600,8 +2562,32 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo16(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "Result":
-			easyjsonC80ae7adDecode5(in, &out.Result)
+		case "TransactionType":
+			out.TransactionType = string(in.String())
+		case "TransID":
+			out.TransID = string(in.String())
+		case "TransTime":
+			out.TransTime = string(in.String())
+		case "TransAmount":
+			out.TransAmount = string(in.String())
+		case "BusinessShortCode":
+			out.BusinessShortCode = string(in.String())
+		case "BillRefNumber":
+			out.BillRefNumber = string(in.String())
+		case "InvoiceNumber":
+			out.InvoiceNumber = string(in.String())
+		case "OrgAccountBalance":
+			out.OrgAccountBalance = string(in.String())
+		case "ThirdPartyTransID":
+			out.ThirdPartyTransID = string(in.String())
+		case "MSISDN":
+			out.MSISDN = string(in.String())
+		case "FirstName":
+			out.FirstName = string(in.String())
+		case "MiddleName":
+			out.MiddleName = string(in.String())
+		case "LastName":
+			out.LastName = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2612,61 +2598,167 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo16(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo16(out *jwriter.Writer, in B2CCallback) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo16(out *jwriter.Writer, in C2BConfirmationRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Result\":"
+		const prefix string = ",\"TransactionType\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TransactionType))
+	}
+	{
+		const prefix string = ",\"TransID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TransID))
+	}
+	{
+		const prefix string = ",\"TransTime\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TransTime))
+	}
+	{
+		const prefix string = ",\"TransAmount\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TransAmount))
+	}
+	{
+		const prefix string = ",\"BusinessShortCode\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.BusinessShortCode))
+	}
+	{
+		const prefix string = ",\"BillRefNumber\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.BillRefNumber))
+	}
+	{
+		const prefix string = ",\"InvoiceNumber\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.InvoiceNumber))
+	}
+	{
+		const prefix string = ",\"OrgAccountBalance\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OrgAccountBalance))
+	}
+	{
+		const prefix string = ",\"ThirdPartyTransID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ThirdPartyTransID))
+	}
+	{
+		const prefix string = ",\"MSISDN\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.MSISDN))
+	}
+	{
+		const prefix string = ",\"FirstName\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"MiddleName\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.MiddleName))
+	}
+	{
+		const prefix string = ",\"LastName\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjsonC80ae7adEncode5(out, in.Result)
+		out.String(string(in.LastName))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v B2CCallback) MarshalJSON() ([]byte, error) {
+func (v C2BConfirmationRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v B2CCallback) MarshalEasyJSON(w *jwriter.Writer) {
+func (v C2BConfirmationRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *B2CCallback) UnmarshalJSON(data []byte) error {
+func (v *C2BConfirmationRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *B2CCallback) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *C2BConfirmationRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo16(l, v)
 }
-func easyjsonC80ae7adDecode5(in *jlexer.Lexer, out *struct {
-	ResultType               int
-	ResultCode               int
-	ResultDesc               string
-	OriginatorConversationID string
-	ConversationID           string
-	TransactionID            string
-	ResultParameters         struct {
-		ResultParameter []struct {
-			Key   string
-			Value json.RawMessage
-		}
-	}
-	ReferenceData json.RawMessage
-}) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(in *jlexer.Lexer, out *C2B) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2675,34 +2767,26 @@ func easyjsonC80ae7adDecode5(in *jlexer.Lexer, out *struct {
 		in.Skip()
 		return
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "ResultType":
-			out.ResultType = int(in.Int())
-		case "ResultCode":
-			out.ResultCode = int(in.Int())
-		case "ResultDesc":
-			out.ResultDesc = string(in.String())
-		case "OriginatorConversationID":
-			out.OriginatorConversationID = string(in.String())
-		case "ConversationID":
-			out.ConversationID = string(in.String())
-		case "TransactionID":
-			out.TransactionID = string(in.String())
-		case "ResultParameters":
-			easyjsonC80ae7adDecode6(in, &out.ResultParameters)
-		case "ReferenceData":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ReferenceData).UnmarshalJSON(data))
-			}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ShortCode":
+			out.ShortCode = string(in.String())
+		case "CommandID":
+			out.CommandID = CommandID(in.String())
+		case "Amount":
+			(out.Amount).UnmarshalEasyJSON(in)
+		case "Msisdn":
+			out.Msisdn = string(in.String())
+		case "BillRefNumber":
+			out.BillRefNumber = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2713,112 +2797,87 @@ func easyjsonC80ae7adDecode5(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncode5(out *jwriter.Writer, in struct {
-	ResultType               int
-	ResultCode               int
-	ResultDesc               string
-	OriginatorConversationID string
-	ConversationID           string
-	TransactionID            string
-	ResultParameters         struct {
-		ResultParameter []struct {
-			Key   string
-			Value json.RawMessage
-		}
-	}
-	ReferenceData json.RawMessage
-}) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(out *jwriter.Writer, in C2B) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ResultType\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int(int(in.ResultType))
-	}
-	{
-		const prefix string = ",\"ResultCode\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Int(int(in.ResultCode))
-	}
-	{
-		const prefix string = ",\"ResultDesc\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.ResultDesc))
-	}
-	{
-		const prefix string = ",\"OriginatorConversationID\":"
+		const prefix string = ",\"ShortCode\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.OriginatorConversationID))
+		out.String(string(in.ShortCode))
 	}
 	{
-		const prefix string = ",\"ConversationID\":"
+		const prefix string = ",\"CommandID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.ConversationID))
+		out.String(string(in.CommandID))
 	}
 	{
-		const prefix string = ",\"TransactionID\":"
+		const prefix string = ",\"Amount\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.TransactionID))
+		(in.Amount).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"ResultParameters\":"
+		const prefix string = ",\"Msisdn\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		easyjsonC80ae7adEncode6(out, in.ResultParameters)
+		out.String(string(in.Msisdn))
 	}
-	{
-		const prefix string = ",\"ReferenceData\":"
+	if in.BillRefNumber != "" {
+		const prefix string = ",\"BillRefNumber\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((in.ReferenceData).MarshalJSON())
+		out.String(string(in.BillRefNumber))
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecode6(in *jlexer.Lexer, out *struct {
-	ResultParameter []struct {
-		Key   string
-		Value json.RawMessage
-	}
-}) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v C2B) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v C2B) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *C2B) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *C2B) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo18(in *jlexer.Lexer, out *B2CResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2837,38 +2896,14 @@ func easyjsonC80ae7adDecode6(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "ResultParameter":
-			if in.IsNull() {
-				in.Skip()
-				out.ResultParameter = nil
-			} else {
-				in.Delim('[')
-				if out.ResultParameter == nil {
-					if !in.IsDelim(']') {
-						out.ResultParameter = make([]struct {
-							Key   string
-							Value json.RawMessage
-						}, 0, 1)
-					} else {
-						out.ResultParameter = []struct {
-							Key   string
-							Value json.RawMessage
-						}{}
-					}
-				} else {
-					out.ResultParameter = (out.ResultParameter)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 struct {
-						Key   string
-						Value json.RawMessage
-					}
-					easyjsonC80ae7adDecode7(in, &v4)
-					out.ResultParameter = append(out.ResultParameter, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "OriginatorConversationID":
+			out.OriginatorConversationID = string(in.String())
+		case "ConversationID":
+			out.ConversationID = string(in.String())
+		case "ResponseDescription":
+			out.ResponseDescription = string(in.String())
+		case "ResponseCode":
+			out.ResponseCode = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2879,42 +2914,77 @@ func easyjsonC80ae7adDecode6(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncode6(out *jwriter.Writer, in struct {
-	ResultParameter []struct {
-		Key   string
-		Value json.RawMessage
-	}
-}) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo18(out *jwriter.Writer, in B2CResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"ResultParameter\":"
+	if in.OriginatorConversationID != "" {
+		const prefix string = ",\"OriginatorConversationID\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		if in.ResultParameter == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
+		out.String(string(in.OriginatorConversationID))
+	}
+	if in.ConversationID != "" {
+		const prefix string = ",\"ConversationID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
 		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.ResultParameter {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				easyjsonC80ae7adEncode7(out, v6)
-			}
-			out.RawByte(']')
+			out.RawString(prefix)
+		}
+		out.String(string(in.ConversationID))
+	}
+	if in.ResponseDescription != "" {
+		const prefix string = ",\"ResponseDescription\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ResponseDescription))
+	}
+	if in.ResponseCode != "" {
+		const prefix string = ",\"ResponseCode\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
+		out.String(string(in.ResponseCode))
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecode7(in *jlexer.Lexer, out *struct {
-	Key   string
-	Value json.RawMessage
-}) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v B2CResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v B2CResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *B2CResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *B2CResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo18(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo19(in *jlexer.Lexer, out *B2CCallback) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2933,12 +3003,8 @@ func easyjsonC80ae7adDecode7(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "Key":
-			out.Key = string(in.String())
-		case "Value":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Value).UnmarshalJSON(data))
-			}
+		case "Result":
+			easyjsonC80ae7adDecode(in, &out.Result)
 		default:
 			in.SkipRecursive()
 		}
@@ -2949,36 +3015,47 @@ func easyjsonC80ae7adDecode7(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncode7(out *jwriter.Writer, in struct {
-	Key   string
-	Value json.RawMessage
-}) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo19(out *jwriter.Writer, in B2CCallback) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Key\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Key))
-	}
-	{
-		const prefix string = ",\"Value\":"
+		const prefix string = ",\"Result\":"
 		if first {
 			first = false
 			out.RawString(prefix[1:])
 		} else {
 			out.RawString(prefix)
 		}
-		out.Raw((in.Value).MarshalJSON())
+		easyjsonC80ae7adEncode(out, in.Result)
 	}
 	out.RawByte('}')
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(in *jlexer.Lexer, out *B2C) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v B2CCallback) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v B2CCallback) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *B2CCallback) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *B2CCallback) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo19(l, v)
+}
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo20(in *jlexer.Lexer, out *B2C) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3002,9 +3079,9 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(in *jlexer.Lexer, out
 		case "SecurityCredential":
 			out.SecurityCredential = string(in.String())
 		case "CommandID":
-			out.CommandID = string(in.String())
+			out.CommandID = CommandID(in.String())
 		case "Amount":
-			out.Amount = string(in.String())
+			(out.Amount).UnmarshalEasyJSON(in)
 		case "PartyA":
 			out.PartyA = string(in.String())
 		case "PartyB":
@@ -3017,6 +3094,8 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(in *jlexer.Lexer, out
 			out.ResultURL = string(in.String())
 		case "Occasion":
 			out.Occasion = string(in.String())
+		case "OriginatorConversationID":
+			out.OriginatorConversationID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3027,7 +3106,7 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(out *jwriter.Writer, in B2C) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo20(out *jwriter.Writer, in B2C) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3069,7 +3148,7 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(out *jwriter.Writer, i
 		} else {
 			out.RawString(prefix)
 		}
-		out.String(string(in.Amount))
+		(in.Amount).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"PartyA\":"
@@ -3131,33 +3210,43 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(out *jwriter.Writer, i
 		}
 		out.String(string(in.Occasion))
 	}
+	if in.OriginatorConversationID != "" {
+		const prefix string = ",\"OriginatorConversationID\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OriginatorConversationID))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v B2C) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(&w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v B2C) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo17(w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *B2C) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(&r, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *B2C) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo17(l, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo20(l, v)
 }
-func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo18(in *jlexer.Lexer, out *APIError) {
+func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo21(in *jlexer.Lexer, out *APIError) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3216,7 +3305,7 @@ func easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo18(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo18(out *jwriter.Writer, in APIError) {
+func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo21(out *jwriter.Writer, in APIError) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3268,23 +3357,23 @@ func easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo18(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v APIError) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo18(&w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIError) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo18(w, v)
+	easyjsonC80ae7adEncodeGithubComDevimteamMpesaApiGo21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIError) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo18(&r, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIError) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo18(l, v)
+	easyjsonC80ae7adDecodeGithubComDevimteamMpesaApiGo21(l, v)
 }
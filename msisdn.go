@@ -0,0 +1,124 @@
+package mpesa
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidMSISDN      = errors.New("invalid Kenyan mobile number")
+	ErrNotSafaricomMSISDN = errors.New("not a Safaricom mobile number")
+)
+
+// MSISDN is a Kenyan mobile number in the canonical form 2547XXXXXXXX or 2541XXXXXXXX,
+// which is expected by Daraja.
+type MSISDN string
+
+// safaricomPrefixes are the first digits of Safaricom numbers after the country code.
+var safaricomPrefixes = []string{
+	"70", "71", "72", "79",
+	"740", "741", "742", "743", "744", "745", "746", "748",
+	"757", "758", "759", "768", "769",
+	"110", "111", "112", "113", "114", "115",
+}
+
+// NormalizeMSISDN converts the Kenyan mobile number in any common format,
+// e.g. "0712345678", "712345678", "+254 712 345 678" or "00254712345678", to the canonical form.
+// It does not check the operator, see ParseMSISDN.
+func NormalizeMSISDN(s string) (MSISDN, error) {
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '\t':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s))
+	digits = strings.TrimPrefix(digits, "+")
+	digits = strings.TrimPrefix(digits, "00")
+	switch {
+	case len(digits) == 12 && strings.HasPrefix(digits, "254"):
+	case len(digits) == 10 && digits[0] == '0':
+		digits = "254" + digits[1:]
+	case len(digits) == 9:
+		digits = "254" + digits
+	default:
+		return "", errors.Wrap(ErrInvalidMSISDN, s)
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", errors.Wrap(ErrInvalidMSISDN, s)
+		}
+	}
+	if digits[3] != '7' && digits[3] != '1' {
+		return "", errors.Wrap(ErrInvalidMSISDN, s)
+	}
+	return MSISDN(digits), nil
+}
+
+// ParseMSISDN normalizes the number like NormalizeMSISDN and checks that it belongs to Safaricom.
+func ParseMSISDN(s string) (MSISDN, error) {
+	m, err := NormalizeMSISDN(s)
+	if err != nil {
+		return "", err
+	}
+	if !m.IsSafaricom() {
+		return "", errors.Wrap(ErrNotSafaricomMSISDN, s)
+	}
+	return m, nil
+}
+
+// IsSafaricom reports whether the number is in one of Safaricom ranges.
+func (m MSISDN) IsSafaricom() bool {
+	if len(m) != 12 {
+		return false
+	}
+	local := string(m[3:])
+	for _, p := range safaricomPrefixes {
+		if strings.HasPrefix(local, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the canonical form, e.g. "254712345678".
+func (m MSISDN) String() string {
+	return string(m)
+}
+
+// Local returns the number in the local form, e.g. "0712345678".
+func (m MSISDN) Local() string {
+	if len(m) != 12 {
+		return string(m)
+	}
+	return "0" + string(m[3:])
+}
+
+// International returns the number in the international form, e.g. "+254712345678".
+func (m MSISDN) International() string {
+	return "+" + string(m)
+}
+
+// Mask hides the digits of the number except the country code and the last three, e.g. "254******678".
+// Use it to write numbers to logs.
+func (m MSISDN) Mask() string {
+	return maskMSISDN(string(m))
+}
+
+// WithMSISDNNormalization enables or disables normalization of phone numbers of requests:
+// Payment.PartyA, Payment.PhoneNumber, B2C.PartyB and C2B.Msisdn.
+// Numbers which can not be normalized are sent as is. It is enabled by default.
+func WithMSISDNNormalization(normalize bool) Option {
+	return func(s *Service) {
+		s.normalizeMSISDN = normalize
+	}
+}
+
+// normalizeMSISDN returns the canonical form of the number or the number as is if it is not valid.
+func normalizeMSISDN(s string) string {
+	if m, err := NormalizeMSISDN(s); err == nil {
+		return m.String()
+	}
+	return s
+}
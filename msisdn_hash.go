@@ -0,0 +1,123 @@
+package mpesa
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownMSISDN is returned by MSISDNResolver when the hashed number is not in the directory.
+var ErrUnknownMSISDN = errors.New("hashed msisdn is not in the directory")
+
+// HashMSISDN returns the SHA-256 hash of the canonical number in lower case hex,
+// as it is sent by M-Pesa in C2B requests.
+func HashMSISDN(m MSISDN) string {
+	sum := sha256.Sum256([]byte(m))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsHashedMSISDN reports whether s looks like the SHA-256 hash of the number rather than the number itself.
+func IsHashedMSISDN(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// PhoneDirectory finds the customer number by its SHA-256 hash returned by HashMSISDN.
+// It reports false if the number is unknown.
+type PhoneDirectory interface {
+	LookupHash(ctx context.Context, hash string) (MSISDN, bool, error)
+}
+
+// PhoneDirectoryFunc is an adapter to use ordinary functions as PhoneDirectory.
+type PhoneDirectoryFunc func(ctx context.Context, hash string) (MSISDN, bool, error)
+
+func (f PhoneDirectoryFunc) LookupHash(ctx context.Context, hash string) (MSISDN, bool, error) {
+	return f(ctx, hash)
+}
+
+// MSISDNIndex is an in-memory PhoneDirectory with precomputed hashes of known customer numbers.
+// It is safe for concurrent use.
+type MSISDNIndex struct {
+	mu     sync.RWMutex
+	hashes map[string]MSISDN
+}
+
+// NewMSISDNIndex returns the index of the numbers.
+func NewMSISDNIndex(numbers ...MSISDN) *MSISDNIndex {
+	idx := &MSISDNIndex{hashes: make(map[string]MSISDN, len(numbers))}
+	idx.Add(numbers...)
+	return idx
+}
+
+// Add adds the numbers to the index. They are normalized like NormalizeMSISDN does,
+// because M-Pesa hashes the canonical form. Numbers which can not be normalized are added as is.
+func (idx *MSISDNIndex) Add(numbers ...MSISDN) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, m := range numbers {
+		m = MSISDN(normalizeMSISDN(string(m)))
+		idx.hashes[HashMSISDN(m)] = m
+	}
+}
+
+// Remove removes the numbers from the index. They are normalized like in Add.
+func (idx *MSISDNIndex) Remove(numbers ...MSISDN) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, m := range numbers {
+		delete(idx.hashes, HashMSISDN(MSISDN(normalizeMSISDN(string(m)))))
+	}
+}
+
+// Len returns the number of indexed numbers.
+func (idx *MSISDNIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.hashes)
+}
+
+func (idx *MSISDNIndex) LookupHash(_ context.Context, hash string) (MSISDN, bool, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	m, ok := idx.hashes[hash]
+	return m, ok, nil
+}
+
+// MSISDNResolver resolves the MSISDN field of C2B requests, which is either the number or its SHA-256 hash.
+type MSISDNResolver struct {
+	directory PhoneDirectory
+}
+
+// NewMSISDNResolver returns the resolver which looks up hashed numbers in the directory.
+func NewMSISDNResolver(directory PhoneDirectory) *MSISDNResolver {
+	return &MSISDNResolver{directory: directory}
+}
+
+// Resolve returns the number of the customer, e.g. from C2BConfirmationRequest.MSISDN.
+// Plain numbers are normalized, hashed numbers are looked up in the directory,
+// ErrUnknownMSISDN is returned if the directory does not have the number.
+// Hashed numbers can not be resolved without the directory.
+func (r *MSISDNResolver) Resolve(ctx context.Context, msisdn string) (MSISDN, error) {
+	msisdn = strings.TrimSpace(msisdn)
+	if !IsHashedMSISDN(msisdn) {
+		return NormalizeMSISDN(msisdn)
+	}
+	if r.directory == nil {
+		return "", errors.New("could not look up hashed msisdn: no phone directory")
+	}
+	m, ok, err := r.directory.LookupHash(ctx, strings.ToLower(msisdn))
+	if err != nil {
+		return "", errors.Wrap(err, "could not look up hashed msisdn")
+	}
+	if !ok {
+		return "", ErrUnknownMSISDN
+	}
+	return m, nil
+}
@@ -0,0 +1,147 @@
+package mpesa
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Option configures a Service. Options are applied once by New,
+// the Service configuration can not be changed afterwards.
+type Option func(*Service)
+
+// WithHTTPClient sets the HTTP client used for all requests to Daraja.
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *Service) {
+		s.httpClient = client
+	}
+}
+
+// WithTimeout limits the time of every call, including token refresh and retries.
+// Zero means no limit besides the one of the HTTP client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(s *Service) {
+		s.timeout = timeout
+	}
+}
+
+// WithLogger sets the logger of the Service. By default nothing is logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Service) {
+		s.logger = logger
+	}
+}
+
+// WithTokenStore sets the storage of OAuth access tokens.
+// It may be used to share one token between several Service instances or processes.
+func WithTokenStore(store TokenStore) Option {
+	return func(s *Service) {
+		s.tokenStore = store
+	}
+}
+
+// WithTokenLiveDuration sets how long the token is considered valid
+// when Daraja does not report the expiration time.
+func WithTokenLiveDuration(d time.Duration) Option {
+	return func(s *Service) {
+		s.tokenLiveDuration = d
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request.
+func WithUserAgent(userAgent string) Option {
+	return func(s *Service) {
+		s.userAgent = userAgent
+	}
+}
+
+// WithRetryPolicy sets the policy of retrying failed requests.
+// By default DefaultRetryPolicy is used. Use RetryPolicy{} to disable retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(s *Service) {
+		s.retryPolicy = policy
+	}
+}
+
+// WithClock sets the source of current time. Useful for tests.
+func WithClock(clock Clock) Option {
+	return func(s *Service) {
+		s.clock = clock
+	}
+}
+
+// WithPath overrides the path of api relative to the endpoint,
+// e.g. WithPath(APIB2C, "mpesa/b2c/v3/paymentrequest").
+func WithPath(api API, path string) Option {
+	return func(s *Service) {
+		s.paths[api] = path
+	}
+}
+
+// CallOption configures a single call of a Service method.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	ctx            context.Context
+	header         http.Header
+	timeout        time.Duration
+	idempotencyKey string
+	meta           *ResponseMeta
+	correlation    *correlationOption
+}
+
+// WithContext sets the context of the call.
+func WithContext(ctx context.Context) CallOption {
+	return func(o *callOptions) {
+		o.ctx = ctx
+	}
+}
+
+// WithHeader adds the header to the request sent to Daraja.
+func WithHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		o.header.Add(key, value)
+	}
+}
+
+// WithCallTimeout overrides the timeout of the Service for this call.
+func WithCallTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithIdempotencyKey sets the key which uniquely identifies the operation on the caller side.
+// The key is passed to RetryPolicy.Checker, which decides whether the money-moving call may be retried;
+// without the Checker the key does not enable retries. B2C requests without OriginatorConversationID
+// send the key in it, so StatusChecker can query the transaction by the key.
+// It is also sent in the Idempotency-Key header for proxies and logs, Daraja ignores it.
+func WithIdempotencyKey(key string) CallOption {
+	return func(o *callOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+func (s *Service) callOptions(opts []CallOption) *callOptions {
+	o := &callOptions{
+		ctx:     context.Background(),
+		header:  make(http.Header),
+		timeout: s.timeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Clock is a source of current time.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,248 @@
+// Package otelmpesa traces exchanges with Daraja and M-Pesa callbacks with OpenTelemetry.
+//
+//	tracer := otelmpesa.New()
+//	service := mpesa.New(key, secret, mpesa.ProductionEndpoint, mpesa.WithInterceptor(tracer.Interceptor()))
+//
+// Pass the context of the request to the Service methods with mpesa.WithContext,
+// and call PaymentCallback or B2CCallback in the callback handler, so the callback span
+// continues the trace of the original request.
+package otelmpesa
+
+import (
+	"context"
+	"sync"
+
+	"github.com/devimteam/mpesa-api-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/devimteam/mpesa-api-go/otelmpesa"
+
+// Attribute keys set on spans.
+const (
+	APIKey                      = attribute.Key("mpesa.api")
+	ShortCodeKey                = attribute.Key("mpesa.shortcode")
+	CommandIDKey                = attribute.Key("mpesa.command_id")
+	AttemptKey                  = attribute.Key("mpesa.attempt")
+	ResponseCodeKey             = attribute.Key("mpesa.response_code")
+	ResultCodeKey               = attribute.Key("mpesa.result_code")
+	ErrorCodeKey                = attribute.Key("mpesa.error_code")
+	ConversationIDKey           = attribute.Key("mpesa.conversation_id")
+	OriginatorConversationIDKey = attribute.Key("mpesa.originator_conversation_id")
+	CheckoutRequestIDKey        = attribute.Key("mpesa.checkout_request_id")
+	MerchantRequestIDKey        = attribute.Key("mpesa.merchant_request_id")
+	HTTPStatusCodeKey           = attribute.Key("http.response.status_code")
+)
+
+const defaultCapacity = 10000
+
+// Tracer creates spans for exchanges with Daraja and callbacks.
+// It remembers span contexts of the last requests which expect a callback,
+// so the callback is traced as a child of the request.
+type Tracer struct {
+	tracer   trace.Tracer
+	capacity int
+
+	// inflight are spans of exchanges being sent, keyed by the exchange,
+	// so they do not depend on the context of the request, which later interceptors may replace.
+	inflight sync.Map
+
+	mu    sync.Mutex
+	spans map[string]trace.SpanContext
+	// keys in the order of adding, used to forget the oldest spans.
+	keys []string
+}
+
+// Option configures the Tracer.
+type Option func(*Tracer)
+
+// WithTracerProvider sets the provider of the tracer. By default the global provider is used.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(t *Tracer) {
+		t.tracer = provider.Tracer(instrumentationName)
+	}
+}
+
+// WithCapacity sets how many requests waiting for a callback are remembered.
+func WithCapacity(capacity int) Option {
+	return func(t *Tracer) {
+		t.capacity = capacity
+	}
+}
+
+// New returns a new Tracer.
+func New(opts ...Option) *Tracer {
+	t := &Tracer{
+		tracer:   otel.GetTracerProvider().Tracer(instrumentationName),
+		capacity: defaultCapacity,
+		spans:    make(map[string]trace.SpanContext),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Interceptor returns the interceptor which traces exchanges of the Service.
+func (t *Tracer) Interceptor() mpesa.Interceptor {
+	return interceptor{t}
+}
+
+type interceptor struct {
+	t *Tracer
+}
+
+func (i interceptor) BeforeSend(ex *mpesa.Exchange) error {
+	attrs := []attribute.KeyValue{
+		APIKey.String(string(ex.API)),
+		AttemptKey.Int(ex.Attempt),
+	}
+	if ex.ShortCode != "" {
+		attrs = append(attrs, ShortCodeKey.String(ex.ShortCode))
+	}
+	if id := commandID(ex.Input); id != "" {
+		attrs = append(attrs, CommandIDKey.String(string(id)))
+	}
+	ctx, span := i.t.tracer.Start(ex.Request.Context(), "mpesa "+string(ex.API),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	i.t.inflight.Store(ex, span)
+	ex.Request = ex.Request.WithContext(ctx)
+	return nil
+}
+
+func (i interceptor) AfterReceive(ex *mpesa.Exchange) error {
+	v, ok := i.t.inflight.LoadAndDelete(ex)
+	if !ok {
+		return nil
+	}
+	span := v.(trace.Span)
+	defer span.End()
+	if ex.Response != nil {
+		span.SetAttributes(HTTPStatusCodeKey.Int(ex.Response.StatusCode))
+	}
+	switch out := ex.Output.(type) {
+	case *mpesa.PaymentResponse:
+		span.SetAttributes(
+			ResponseCodeKey.String(out.ResponseCode),
+			MerchantRequestIDKey.String(out.MerchantRequestID),
+			CheckoutRequestIDKey.String(out.CheckoutRequestID),
+		)
+		i.t.remember(out.CheckoutRequestID, span.SpanContext())
+	case mpesa.APIError:
+		if out.ErrorCode != nil {
+			span.SetAttributes(ErrorCodeKey.String(*out.ErrorCode))
+		}
+	default:
+		if r := genericResponse(out); r != nil {
+			span.SetAttributes(
+				ResponseCodeKey.String(r.ResponseCode),
+				ConversationIDKey.String(r.ConversationID),
+				OriginatorConversationIDKey.String(r.OriginatorConversationID),
+			)
+			i.t.remember(r.ConversationID, span.SpanContext())
+		}
+	}
+	if ex.Err != nil {
+		span.RecordError(ex.Err)
+		span.SetStatus(codes.Error, ex.Err.Error())
+	}
+	return nil
+}
+
+// PaymentCallback starts the span of handling the STK push callback.
+// The span is the child of the span of the original request if it is remembered,
+// and is linked to the span in ctx, usually the span of the incoming HTTP request.
+func (t *Tracer) PaymentCallback(ctx context.Context, cb *mpesa.PaymentCallback) (context.Context, trace.Span) {
+	stk := cb.Body.STKCallback
+	return t.startCallback(ctx, "mpesa callback stk_push", stk.CheckoutRequestID,
+		ResultCodeKey.Int(stk.ResultCode),
+		MerchantRequestIDKey.String(stk.MerchantRequestID),
+		CheckoutRequestIDKey.String(stk.CheckoutRequestID),
+	)
+}
+
+// B2CCallback starts the span of handling the B2C result, see PaymentCallback.
+func (t *Tracer) B2CCallback(ctx context.Context, cb *mpesa.B2CCallback) (context.Context, trace.Span) {
+	res := cb.Result
+	return t.startCallback(ctx, "mpesa callback b2c", res.ConversationID,
+		ResultCodeKey.Int(res.ResultCode),
+		ConversationIDKey.String(res.ConversationID),
+		OriginatorConversationIDKey.String(res.OriginatorConversationID),
+	)
+}
+
+func (t *Tracer) startCallback(ctx context.Context, name, key string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	}
+	if current := trace.SpanContextFromContext(ctx); current.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: current}))
+	}
+	if sc, ok := t.forget(key); ok {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+	}
+	return t.tracer.Start(ctx, name, opts...)
+}
+
+func (t *Tracer) remember(key string, sc trace.SpanContext) {
+	if key == "" || !sc.IsValid() || t.capacity <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.spans[key]; !ok {
+		t.keys = append(t.keys, key)
+	}
+	t.spans[key] = sc
+	for len(t.keys) > t.capacity {
+		delete(t.spans, t.keys[0])
+		t.keys = t.keys[1:]
+	}
+}
+
+func (t *Tracer) forget(key string) (trace.SpanContext, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sc, ok := t.spans[key]
+	// The key stays in keys until it is evicted, which is cheaper than searching it.
+	delete(t.spans, key)
+	return sc, ok
+}
+
+func commandID(input interface{}) mpesa.CommandID {
+	switch r := input.(type) {
+	case mpesa.C2B:
+		return r.CommandID
+	case mpesa.B2C:
+		return r.CommandID
+	case mpesa.TransactionStatus:
+		return r.CommandID
+	case mpesa.Reversal:
+		return r.CommandID
+	case mpesa.Payment:
+		return r.TransactionType
+	}
+	return ""
+}
+
+func genericResponse(output interface{}) *mpesa.GenericResponse {
+	switch r := output.(type) {
+	case *mpesa.B2CResponse:
+		return (*mpesa.GenericResponse)(r)
+	case *mpesa.C2BResponse:
+		return (*mpesa.GenericResponse)(r)
+	case *mpesa.C2BRegisterURLResponse:
+		return (*mpesa.GenericResponse)(r)
+	case *mpesa.TransactionStatusResponse:
+		return (*mpesa.GenericResponse)(r)
+	case *mpesa.ReversalAcknowledgement:
+		return (*mpesa.GenericResponse)(r)
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package mpesa
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"github.com/pkg/errors"
+)
+
+// ResultParameter is a key-value item of result parameters and reference data of async results.
+type ResultParameter struct {
+	Key   string
+	Value json.RawMessage `json:",omitempty"`
+}
+
+// ResultParameters is a list of result parameters.
+// Daraja sends a single parameter as an object instead of an array, both are decoded.
+type ResultParameters []ResultParameter
+
+// Get returns the value of the parameter. Parameters without a value are treated as missing.
+func (p ResultParameters) Get(key string) (json.RawMessage, bool) {
+	for _, param := range p {
+		if param.Key == key {
+			return param.Value, len(param.Value) != 0
+		}
+	}
+	return nil, false
+}
+
+// Decode decodes the parameters into the struct pointed to by v. Fields are matched by the mpesa tag:
+//
+//	type Result struct {
+//		Amount    mpesa.Amount `mpesa:"TransactionAmount"`
+//		Receipt   string       `mpesa:"TransactionReceipt"`
+//		Completed time.Time    `mpesa:"TransactionCompletedDateTime"`
+//		Charges   mpesa.Amount `mpesa:"B2CChargesPaidAccountAvailableFunds,optional"`
+//	}
+//
+// Fields without the tag are skipped. Missing parameters result in ErrMissingItem unless the field is optional.
+// Values are decoded both from strings and numbers:
+//   - string, integer and float fields; bool fields from "Y", "N", "true", "false", 1 and 0;
+//   - Amount, MSISDN and time.Time in EAT in any Daraja format, e.g. 20191219102115 or "19.12.2019 11:45:50";
+//   - types implementing json.Unmarshaler or encoding.TextUnmarshaler.
+//
+// Public names of parties, e.g. "254708374149 - John Doe", are split with the phone and name options:
+// `mpesa:"ReceiverPartyPublicName,phone"` and `mpesa:"ReceiverPartyPublicName,name"`.
+func (p ResultParameters) Decode(v interface{}) error {
+	return decodeParameters(p.Get, v)
+}
+
+func (p ResultParameters) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]ResultParameter(p))
+}
+
+func (p *ResultParameters) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*p = nil
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		var param ResultParameter
+		if err := json.Unmarshal(data, &param); err != nil {
+			return err
+		}
+		*p = ResultParameters{param}
+		return nil
+	}
+	var params []ResultParameter
+	if err := json.Unmarshal(data, &params); err != nil {
+		return err
+	}
+	*p = params
+	return nil
+}
+
+func (p ResultParameters) MarshalEasyJSON(w *jwriter.Writer) {
+	data, err := p.MarshalJSON()
+	w.Raw(data, err)
+}
+
+func (p *ResultParameters) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	data := l.Raw()
+	if l.Ok() {
+		l.AddError(p.UnmarshalJSON(data))
+	}
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	amountType          = reflect.TypeOf(Amount(0))
+	msisdnType          = reflect.TypeOf(MSISDN(""))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// decodeParameters decodes the values returned by get into the tagged fields of the struct pointed to by v.
+func decodeParameters(get func(key string) (json.RawMessage, bool), v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("could not decode parameters into %T: pointer to struct is expected", v)
+	}
+	rv = rv.Elem()
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("mpesa")
+		if !ok || tag == "-" || f.PkgPath != "" {
+			continue
+		}
+		key, options := parseParameterTag(tag)
+		value, ok := get(key)
+		if !ok {
+			if options["optional"] {
+				continue
+			}
+			return errors.Wrap(ErrMissingItem, key)
+		}
+		s := rawString(value)
+		switch {
+		case options["phone"]:
+			s, _ = splitPublicName(s)
+		case options["name"]:
+			_, s = splitPublicName(s)
+		}
+		if err := setParameter(rv.Field(i), value, s); err != nil {
+			return errors.Wrap(err, key)
+		}
+	}
+	return nil
+}
+
+func parseParameterTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, ",")
+	options := make(map[string]bool, len(parts)-1)
+	for _, o := range parts[1:] {
+		options[strings.TrimSpace(o)] = true
+	}
+	return parts[0], options
+}
+
+// setParameter sets the field from the raw JSON value or from its string form s.
+func setParameter(field reflect.Value, raw json.RawMessage, s string) error {
+	switch field.Type() {
+	case timeType:
+		t, err := ParseTime(s)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	case amountType:
+		a, err := parseAmount(s, false)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(a))
+		return nil
+	case msisdnType:
+		m, err := NormalizeMSISDN(s)
+		if err != nil {
+			return err
+		}
+		field.SetString(string(m))
+		return nil
+	}
+	ptr := field.Addr()
+	if ptr.Type().Implements(jsonUnmarshalerType) {
+		return ptr.Interface().(json.Unmarshaler).UnmarshalJSON(raw)
+	}
+	if ptr.Type().Implements(textUnmarshalerType) {
+		return ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		switch strings.ToLower(s) {
+		case "y", "yes", "true", "1":
+			field.SetBool(true)
+		case "n", "no", "false", "0":
+			field.SetBool(false)
+		default:
+			return errors.Errorf("invalid bool %q", s)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(n)
+	default:
+		return errors.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
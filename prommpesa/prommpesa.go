@@ -0,0 +1,187 @@
+// Package prommpesa exposes Prometheus metrics of exchanges with Daraja, OAuth tokens and M-Pesa callbacks.
+//
+//	metrics := prommpesa.New()
+//	prometheus.MustRegister(metrics)
+//	service := mpesa.New(key, secret, mpesa.ProductionEndpoint, mpesa.WithInterceptor(metrics.Interceptor()))
+//
+// Callbacks are received by the application, so it should pass them to ObservePaymentCallback,
+// ObserveB2CCallback or ObserveCallback.
+package prommpesa
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Outcomes of requests.
+const (
+	OutcomeSuccess = "success"
+	// Daraja responded, but ResponseCode of the acknowledgement does not mean it accepted the request,
+	// see mpesa.ResponseCodeAccepted.
+	OutcomeRejected = "rejected"
+	// Daraja responded with an error.
+	OutcomeAPIError = "api_error"
+	// The request was not sent or the response was not received.
+	OutcomeNetworkError = "network_error"
+)
+
+// Types of callbacks.
+const (
+	CallbackSTKPush = "stk_push"
+	CallbackB2C     = "b2c"
+)
+
+// Metrics collects metrics of the Service. It implements prometheus.Collector.
+type Metrics struct {
+	requests     *prometheus.CounterVec
+	latency      *prometheus.HistogramVec
+	errors       *prometheus.CounterVec
+	tokenRefresh *prometheus.CounterVec
+	tokenAge     prometheus.GaugeFunc
+	callbacks    *prometheus.CounterVec
+	unknown      *prometheus.CounterVec
+
+	mu             sync.Mutex
+	tokenUpdatedAt time.Time
+}
+
+// New returns new Metrics. Names of all metrics start with namespace "mpesa".
+func New() *Metrics {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpesa",
+			Name:      "requests_total",
+			Help:      "Number of requests to Daraja by API and outcome.",
+		}, []string{"api", "outcome"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "mpesa",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of requests to Daraja by API and outcome.",
+			Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}, []string{"api", "outcome"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpesa",
+			Name:      "errors_total",
+			Help:      "Number of Daraja errors by API and error code or ResponseCode.",
+		}, []string{"api", "code"}),
+		tokenRefresh: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpesa",
+			Name:      "token_refreshes_total",
+			Help:      "Number of OAuth token refreshes by result.",
+		}, []string{"result"}),
+		callbacks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpesa",
+			Name:      "callbacks_total",
+			Help:      "Number of received callbacks by type and ResultCode.",
+		}, []string{"type", "result_code"}),
+		unknown: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mpesa",
+			Name:      "unknown_fields_total",
+			Help:      "Number of responses with fields unknown to the library by API and field.",
+		}, []string{"api", "field"}),
+	}
+	m.tokenAge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "mpesa",
+		Name:      "token_age_seconds",
+		Help:      "Time since the last successful OAuth token refresh.",
+	}, m.currentTokenAge)
+	return m
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.latency.Describe(ch)
+	m.errors.Describe(ch)
+	m.tokenRefresh.Describe(ch)
+	m.tokenAge.Describe(ch)
+	m.callbacks.Describe(ch)
+	m.unknown.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.latency.Collect(ch)
+	m.errors.Collect(ch)
+	m.tokenRefresh.Collect(ch)
+	m.tokenAge.Collect(ch)
+	m.callbacks.Collect(ch)
+	m.unknown.Collect(ch)
+}
+
+// Interceptor returns the interceptor which observes exchanges of the Service.
+func (m *Metrics) Interceptor() mpesa.Interceptor {
+	return mpesa.InterceptorFuncs{After: m.observe}
+}
+
+// UnknownFieldsHook returns the hook counting unknown fields of responses.
+// Use it with mpesa.WithDecodePolicy(mpesa.DecodeLenientReport).
+func (m *Metrics) UnknownFieldsHook() mpesa.UnknownFieldsHook {
+	return func(api mpesa.API, fields []string) {
+		for _, f := range fields {
+			m.unknown.WithLabelValues(string(api), f).Inc()
+		}
+	}
+}
+
+// ObservePaymentCallback counts the STK push callback.
+func (m *Metrics) ObservePaymentCallback(cb *mpesa.PaymentCallback) {
+	m.ObserveCallback(CallbackSTKPush, cb.Body.STKCallback.ResultCode)
+}
+
+// ObserveB2CCallback counts the B2C result.
+func (m *Metrics) ObserveB2CCallback(cb *mpesa.B2CCallback) {
+	m.ObserveCallback(CallbackB2C, cb.Result.ResultCode)
+}
+
+// ObserveCallback counts the callback of the type with the ResultCode.
+func (m *Metrics) ObserveCallback(callbackType string, resultCode int) {
+	m.callbacks.WithLabelValues(callbackType, strconv.Itoa(resultCode)).Inc()
+}
+
+func (m *Metrics) observe(ex *mpesa.Exchange) error {
+	api := string(ex.API)
+	outcome := OutcomeSuccess
+	switch {
+	case ex.Response == nil:
+		outcome = OutcomeNetworkError
+	case ex.Err != nil:
+		outcome = OutcomeAPIError
+		code := strconv.Itoa(ex.Response.StatusCode)
+		if apiErr, ok := ex.Output.(mpesa.APIError); ok && apiErr.ErrorCode != nil {
+			code = *apiErr.ErrorCode
+		}
+		m.errors.WithLabelValues(api, code).Inc()
+	default:
+		if code, _ := mpesa.ResponseCodeOf(ex.Output); !mpesa.ResponseCodeAccepted(code) {
+			outcome = OutcomeRejected
+			m.errors.WithLabelValues(api, code).Inc()
+		}
+	}
+	m.requests.WithLabelValues(api, outcome).Inc()
+	m.latency.WithLabelValues(api, outcome).Observe(ex.Latency.Seconds())
+
+	if ex.API == mpesa.APIOAuth {
+		if ex.Err != nil {
+			m.tokenRefresh.WithLabelValues("failure").Inc()
+		} else {
+			m.tokenRefresh.WithLabelValues("success").Inc()
+			m.mu.Lock()
+			m.tokenUpdatedAt = time.Now()
+			m.mu.Unlock()
+		}
+	}
+	return nil
+}
+
+func (m *Metrics) currentTokenAge() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.tokenUpdatedAt.IsZero() {
+		return 0
+	}
+	return time.Since(m.tokenUpdatedAt).Seconds()
+}
@@ -0,0 +1,158 @@
+package mpesa
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrRateLimited is returned when the request exceeds the client-side rate limit
+// and the Service is configured to fail fast.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// RateLimit allows Rate requests per second on average with bursts up to Burst requests.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// WithRateLimit limits the rate of requests to api.
+func WithRateLimit(api API, limit RateLimit) Option {
+	return func(s *Service) {
+		s.limiter.apis[api] = newTokenBucket(limit)
+	}
+}
+
+// WithShortCodeRateLimit limits the rate of requests made on behalf of shortCode to all APIs.
+// An empty shortCode sets the limit of every shortcode without its own limit.
+func WithShortCodeRateLimit(shortCode string, limit RateLimit) Option {
+	return func(s *Service) {
+		if shortCode == "" {
+			s.limiter.shortCodeDefault = &limit
+			return
+		}
+		s.limiter.shortCodes[shortCode] = newTokenBucket(limit)
+	}
+}
+
+// WithRateLimitFailFast makes calls that exceed the rate limit fail with ErrRateLimited.
+// By default they wait for their turn until the context of the call is done.
+func WithRateLimitFailFast(failFast bool) Option {
+	return func(s *Service) {
+		s.limiter.failFast = failFast
+	}
+}
+
+type rateLimiter struct {
+	failFast bool
+
+	apis             map[API]*tokenBucket
+	shortCodeDefault *RateLimit
+
+	mu         sync.Mutex
+	shortCodes map[string]*tokenBucket
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		apis:       make(map[API]*tokenBucket),
+		shortCodes: make(map[string]*tokenBucket),
+	}
+}
+
+func (l *rateLimiter) shortCodeBucket(shortCode string) *tokenBucket {
+	if shortCode == "" {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.shortCodes[shortCode]
+	if !ok && l.shortCodeDefault != nil {
+		b = newTokenBucket(*l.shortCodeDefault)
+		l.shortCodes[shortCode] = b
+	}
+	return b
+}
+
+// wait blocks until the request to api on behalf of shortCode is allowed.
+// Tokens are reserved from all buckets at the moment now, and returned to them if the request is not allowed.
+func (l *rateLimiter) wait(ctx context.Context, now time.Time, api API, shortCode string) error {
+	var (
+		reserved []*tokenBucket
+		delay    time.Duration
+	)
+	cancel := func() {
+		for _, b := range reserved {
+			b.cancel()
+		}
+	}
+	for _, b := range []*tokenBucket{l.apis[api], l.shortCodeBucket(shortCode)} {
+		if b == nil {
+			continue
+		}
+		d, ok := b.reserve(now, l.failFast)
+		if !ok {
+			cancel()
+			return ErrRateLimited
+		}
+		reserved = append(reserved, b)
+		if d > delay {
+			delay = d
+		}
+	}
+	if err := sleep(ctx, delay); err != nil {
+		cancel()
+		return errors.Wrap(err, "wait for rate limit")
+	}
+	return nil
+}
+
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	burst := math.Max(float64(limit.Burst), 1)
+	return &tokenBucket{
+		rate:   limit.Rate,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+// reserve takes a token and returns the time to wait until it is available.
+// When failFast is true and there is no available token, nothing is taken.
+func (b *tokenBucket) reserve(now time.Time, failFast bool) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.last.IsZero() {
+		b.last = now
+	} else if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	if failFast || b.rate <= 0 {
+		return 0, false
+	}
+	b.tokens--
+	return time.Duration((-b.tokens) / b.rate * float64(time.Second)), true
+}
+
+// cancel returns the reserved token.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+	b.mu.Unlock()
+}
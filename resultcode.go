@@ -0,0 +1,263 @@
+package mpesa
+
+import "strconv"
+
+// Outcome is a typed meaning of the ResultCode of an asynchronous result.
+type Outcome int
+
+const (
+	OutcomeUnknown Outcome = iota
+	OutcomeSuccess
+	// The customer cancelled the STK prompt.
+	OutcomeUserCancelled
+	// The customer did not respond in time or the phone was unreachable.
+	OutcomeTimeout
+	OutcomeInsufficientFunds
+	// The customer entered a wrong PIN.
+	OutcomeInvalidPIN
+	// M-Pesa rejected the request because of its parameters, limits or the parties.
+	OutcomeRejected
+	// M-Pesa could not process the request because of an internal problem.
+	OutcomeSystemError
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeSuccess:
+		return "success"
+	case OutcomeUserCancelled:
+		return "user_cancelled"
+	case OutcomeTimeout:
+		return "timeout"
+	case OutcomeInsufficientFunds:
+		return "insufficient_funds"
+	case OutcomeInvalidPIN:
+		return "invalid_pin"
+	case OutcomeRejected:
+		return "rejected"
+	case OutcomeSystemError:
+		return "system_error"
+	}
+	return "unknown"
+}
+
+// ResultCodeInfo describes the ResultCode of an asynchronous result.
+type ResultCodeInfo struct {
+	Code int
+	// Code of the reply to the C2B validation request, set instead of Code by LookupC2BResultCode.
+	C2BCode C2BResultCode
+	Outcome Outcome
+	// Whether initiating the same transaction again may succeed.
+	Retryable bool
+	// Description for the support team.
+	Description string
+	// Message which may be shown to the customer.
+	CustomerMessage string
+}
+
+// Success reports whether the transaction was completed.
+func (i ResultCodeInfo) Success() bool {
+	return i.Outcome == OutcomeSuccess
+}
+
+// commonResultCodes have the same meaning for all APIs.
+var commonResultCodes = map[int]ResultCodeInfo{
+	0: {
+		Outcome:         OutcomeSuccess,
+		Description:     "The service request is processed successfully.",
+		CustomerMessage: "The payment was successful.",
+	},
+	1: {
+		Outcome:         OutcomeInsufficientFunds,
+		Description:     "The balance is insufficient for the transaction.",
+		CustomerMessage: "You do not have enough money in your M-Pesa account.",
+	},
+	17: {
+		Outcome:         OutcomeSystemError,
+		Retryable:       true,
+		Description:     "System internal error.",
+		CustomerMessage: "M-Pesa could not process the payment. Please try again later.",
+	},
+	26: {
+		Outcome:         OutcomeSystemError,
+		Retryable:       true,
+		Description:     "Traffic blocking condition in place.",
+		CustomerMessage: "M-Pesa is busy. Please try again later.",
+	},
+}
+
+// resultCodes have meanings specific for the API.
+var resultCodes = map[API]map[int]ResultCodeInfo{
+	APISTKPush: {
+		1001: {
+			Outcome:         OutcomeSystemError,
+			Retryable:       true,
+			Description:     "Unable to lock subscriber, a transaction is already in process for the current subscriber.",
+			CustomerMessage: "Another M-Pesa transaction is in progress on your phone. Please try again in a moment.",
+		},
+		1019: {
+			Outcome:         OutcomeTimeout,
+			Retryable:       true,
+			Description:     "Transaction has expired.",
+			CustomerMessage: "The payment request has expired. Please try again.",
+		},
+		1025: {
+			Outcome:         OutcomeSystemError,
+			Retryable:       true,
+			Description:     "An error occurred while sending a push request.",
+			CustomerMessage: "We could not send the payment request to your phone. Please try again.",
+		},
+		1032: {
+			Outcome:         OutcomeUserCancelled,
+			Description:     "Request cancelled by user.",
+			CustomerMessage: "You cancelled the payment request.",
+		},
+		1037: {
+			Outcome:         OutcomeTimeout,
+			Retryable:       true,
+			Description:     "DS timeout, user cannot be reached.",
+			CustomerMessage: "We could not reach your phone. Make sure it is on and has network, then try again.",
+		},
+		2001: {
+			Outcome:         OutcomeInvalidPIN,
+			Retryable:       true,
+			Description:     "The initiator information is invalid: wrong PIN was entered.",
+			CustomerMessage: "You entered a wrong M-Pesa PIN. Please try again.",
+		},
+		9999: {
+			Outcome:         OutcomeSystemError,
+			Retryable:       true,
+			Description:     "An error occurred while sending a push request.",
+			CustomerMessage: "We could not send the payment request to your phone. Please try again.",
+		},
+	},
+	APIB2C: {
+		2: {
+			Outcome:         OutcomeRejected,
+			Description:     "Declined due to limit rule: less than the minimum transaction value.",
+			CustomerMessage: "The amount is less than the minimum allowed.",
+		},
+		3: {
+			Outcome:         OutcomeRejected,
+			Description:     "Declined due to limit rule: greater than the maximum transaction value.",
+			CustomerMessage: "The amount is more than the maximum allowed.",
+		},
+		4: {
+			Outcome:         OutcomeRejected,
+			Description:     "Declined due to limit rule: would exceed the daily transfer limit.",
+			CustomerMessage: "The payment would exceed the daily limit of the recipient.",
+		},
+		8: {
+			Outcome:         OutcomeRejected,
+			Description:     "Declined due to limit rule: would exceed the maximum balance.",
+			CustomerMessage: "The payment would exceed the maximum M-Pesa balance of the recipient.",
+		},
+		11: {
+			Outcome:         OutcomeRejected,
+			Description:     "The DebitParty is in an invalid state.",
+			CustomerMessage: "The payment could not be made. Please contact support.",
+		},
+		2001: {
+			Outcome:         OutcomeRejected,
+			Description:     "The initiator information is invalid: check the initiator name and security credential.",
+			CustomerMessage: "The payment could not be made. Please contact support.",
+		},
+		2028: {
+			Outcome:         OutcomeRejected,
+			Description:     "The request is not permitted according to product assignment.",
+			CustomerMessage: "The payment could not be made. Please contact support.",
+		},
+		2040: {
+			Outcome:         OutcomeRejected,
+			Description:     "Credit party customer type is not supported by the service: the recipient is not a registered M-Pesa customer.",
+			CustomerMessage: "The recipient is not registered for M-Pesa.",
+		},
+	},
+}
+
+// c2bResultCodes describe the replies to C2B validation requests.
+var c2bResultCodes = map[C2BResultCode]ResultCodeInfo{
+	C2BAccepted: {
+		Outcome:         OutcomeSuccess,
+		Description:     "The payment was accepted by the validation URL.",
+		CustomerMessage: "The payment was successful.",
+	},
+	C2BRejectInvalidMSISDN: {
+		Outcome:         OutcomeRejected,
+		Description:     "The payment was rejected by the validation URL: invalid MSISDN.",
+		CustomerMessage: "Payments from your phone number are not accepted.",
+	},
+	C2BRejectInvalidAccountNumber: {
+		Outcome:         OutcomeRejected,
+		Description:     "The payment was rejected by the validation URL: invalid account number.",
+		CustomerMessage: "The account number is invalid. Please check it and pay again.",
+	},
+	C2BRejectInvalidAmount: {
+		Outcome:         OutcomeRejected,
+		Description:     "The payment was rejected by the validation URL: invalid amount.",
+		CustomerMessage: "The amount is invalid. Please check it and pay again.",
+	},
+	C2BRejectInvalidKYCDetails: {
+		Outcome:         OutcomeRejected,
+		Description:     "The payment was rejected by the validation URL: invalid KYC details.",
+		CustomerMessage: "The payment could not be accepted. Please contact support.",
+	},
+	C2BRejectInvalidShortCode: {
+		Outcome:         OutcomeRejected,
+		Description:     "The payment was rejected by the validation URL: invalid short code.",
+		CustomerMessage: "The paybill or till number is invalid. Please check it and pay again.",
+	},
+	C2BRejectOtherError: {
+		Outcome:         OutcomeRejected,
+		Description:     "The payment was rejected by the validation URL: other error.",
+		CustomerMessage: "The payment could not be accepted. Please contact support.",
+	},
+}
+
+// LookupResultCode returns the description of the ResultCode of the result of api.
+// Unknown codes are described as OutcomeUnknown.
+func LookupResultCode(api API, code int) ResultCodeInfo {
+	info, ok := resultCodes[api][code]
+	if !ok {
+		info, ok = commonResultCodes[code]
+	}
+	if !ok {
+		info = ResultCodeInfo{
+			Outcome:         OutcomeUnknown,
+			Description:     "Unknown result code " + strconv.Itoa(code) + ".",
+			CustomerMessage: "The payment could not be completed. Please contact support.",
+		}
+	}
+	info.Code = code
+	return info
+}
+
+// LookupC2BResultCode returns the description of the code of the reply to the C2B validation request.
+// Unknown codes are described as OutcomeUnknown.
+func LookupC2BResultCode(code C2BResultCode) ResultCodeInfo {
+	info, ok := c2bResultCodes[code]
+	if !ok {
+		info = ResultCodeInfo{
+			Outcome:         OutcomeUnknown,
+			Description:     "Unknown C2B result code " + string(code) + ".",
+			CustomerMessage: "The payment could not be completed. Please contact support.",
+		}
+	}
+	info.C2BCode = code
+	return info
+}
+
+// ResultInfo describes the ResultCode of the STK push callback.
+func (cb *PaymentCallback) ResultInfo() ResultCodeInfo {
+	return LookupResultCode(APISTKPush, cb.Body.STKCallback.ResultCode)
+}
+
+// ResultInfo describes the ResultCode of the B2C result.
+func (cb *B2CCallback) ResultInfo() ResultCodeInfo {
+	return LookupResultCode(APIB2C, cb.Result.ResultCode)
+}
+
+// ResultInfo describes the ResultCode of the reply to the C2B validation request.
+func (r C2BReply) ResultInfo() ResultCodeInfo {
+	return LookupC2BResultCode(r.ResultCode)
+}
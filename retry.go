@@ -0,0 +1,117 @@
+package mpesa
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how failed requests are retried.
+//
+// Network errors and retryable APIErrors (429, 5xx and spike arrest) of safe calls (OAuth, transaction status, URL registration)
+// are retried automatically. Money-moving calls (B2C, STK push, C2B simulation, reversal) are retried
+// only when the call has an idempotency key and the IdempotencyChecker confirms that
+// the original request was not processed by M-Pesa.
+//
+// StatusChecker verifies B2C requests with the transaction status query. Without the Checker
+// money-moving calls are never retried, even with an idempotency key.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+	// Delay before the second attempt. Every next delay is twice longer.
+	Backoff time.Duration
+	// Upper bound of the delay. Zero means no bound.
+	MaxBackoff time.Duration
+	// Fraction of the delay, from 0 to 1, which is randomized to spread retries of concurrent calls.
+	Jitter float64
+	// Checker verifies money-moving calls before retrying them.
+	// When it is nil money-moving calls are never retried.
+	Checker IdempotencyChecker
+}
+
+// DefaultRetryPolicy is the retry policy of the Service if no other is set.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+	Jitter:      0.2,
+}
+
+// IdempotencyChecker reports whether the money-moving request with the idempotency key
+// was processed by M-Pesa. Usually it is implemented by looking up the results received
+// on the ResultURL or CallBackURL for the operation with the key, e.g. after waiting for them for a while.
+// It must report true when it can not be sure the request was not processed.
+type IdempotencyChecker interface {
+	Processed(ctx context.Context, api API, idempotencyKey string) (bool, error)
+}
+
+// IdempotencyCheckerFunc is an adapter to use ordinary functions as IdempotencyChecker.
+type IdempotencyCheckerFunc func(ctx context.Context, api API, idempotencyKey string) (bool, error)
+
+func (f IdempotencyCheckerFunc) Processed(ctx context.Context, api API, idempotencyKey string) (bool, error) {
+	return f(ctx, api, idempotencyKey)
+}
+
+// safeAPIs may be sent several times without side effects.
+var safeAPIs = map[API]bool{
+	APIOAuth:             true,
+	APITransactionStatus: true,
+	APIC2BRegisterURL:    true,
+}
+
+// allow reports whether the failed attempt of the call may be retried.
+func (p RetryPolicy) allow(ctx context.Context, c *call, attempt int) (bool, error) {
+	if attempt >= p.MaxAttempts {
+		return false, nil
+	}
+	if safeAPIs[c.api] {
+		return true, nil
+	}
+	if c.opts.idempotencyKey == "" || p.Checker == nil {
+		return false, nil
+	}
+	processed, err := p.Checker.Processed(ctx, c.api, c.opts.idempotencyKey)
+	if err != nil {
+		return false, err
+	}
+	return !processed, nil
+}
+
+// delay returns how long to wait before the next attempt.
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		d -= time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+	if retryAfter > d {
+		d = retryAfter
+	}
+	return d
+}
+
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// parseRetryAfter parses the Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package mpesa
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNoStatusResult is returned by StatusChecker when the result of the transaction status query
+// is not received in time.
+var ErrNoStatusResult = errors.New("transaction status result is not received")
+
+// StatusChecker is the IdempotencyChecker which verifies B2C requests with the transaction status query.
+// The idempotency key of the call is sent as OriginatorConversationID of the B2C request,
+// and the status of the transaction is queried by it as OriginalConversationID.
+//
+// The result of the query is sent asynchronously to ResultURL of the query,
+// so the handler of ResultURL must pass it to HandleResult:
+//
+//	checker := mpesa.NewStatusChecker(mpesa.TransactionStatus{
+//		Initiator:          initiator,
+//		SecurityCredential: credential,
+//		PartyA:             shortCode,
+//		IdentifierType:     mpesa.IdentifierShortCode,
+//		ResultURL:          "https://example.com/mpesa/status",
+//		QueueTimeOutURL:    "https://example.com/mpesa/status/timeout",
+//	}, time.Minute)
+//	service := mpesa.New(key, secret, mpesa.ProductionEndpoint, mpesa.WithRetryPolicy(mpesa.RetryPolicy{
+//		MaxAttempts: 3,
+//		Backoff:     5 * time.Second,
+//		Checker:     checker,
+//	}))
+//
+// The request is reported as not processed only if M-Pesa answers that the transaction does not exist.
+// Other APIs, failed queries and results which are not received in time are reported as processed,
+// so such calls are not retried. A StatusChecker is bound to the Service created with it and must not be shared.
+type StatusChecker struct {
+	query   TransactionStatus
+	timeout time.Duration
+	service *Service
+
+	mu      sync.Mutex
+	pending map[string]chan *TransactionStatusCallback
+}
+
+// NewStatusChecker returns the checker which sends the query built from the template,
+// e.g. with the initiator, the shortcode and result URLs, and waits for its result up to timeout.
+// Occasion of the template is replaced with the idempotency key to match the result.
+func NewStatusChecker(query TransactionStatus, timeout time.Duration) *StatusChecker {
+	if query.CommandID == "" {
+		query.CommandID = CommandTransactionStatusQuery
+	}
+	return &StatusChecker{
+		query:   query,
+		timeout: timeout,
+		pending: make(map[string]chan *TransactionStatusCallback),
+	}
+}
+
+func (c *StatusChecker) bind(s *Service) {
+	c.service = s
+}
+
+func (c *StatusChecker) Processed(ctx context.Context, api API, idempotencyKey string) (bool, error) {
+	if api != APIB2C {
+		return true, nil
+	}
+	if c.service == nil {
+		return true, errors.New("status checker is not used by a Service")
+	}
+	results := make(chan *TransactionStatusCallback, 1)
+	c.mu.Lock()
+	c.pending[idempotencyKey] = results
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, idempotencyKey)
+		c.mu.Unlock()
+	}()
+
+	query := c.query
+	query.TransactionID = ""
+	query.OriginalConversationID = idempotencyKey
+	query.Occasion = idempotencyKey
+	if _, err := c.service.TransactionStatus(query, WithContext(ctx)); err != nil {
+		return true, errors.Wrap(err, "could not query transaction status")
+	}
+
+	timer := time.NewTimer(c.timeout)
+	defer timer.Stop()
+	select {
+	case res := <-results:
+		return res.Result.ResultCode == 0 || !transactionNotFound(res.Result.ResultDesc), nil
+	case <-timer.C:
+		return true, ErrNoStatusResult
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
+
+// HandleResult passes the result of the transaction status query received on ResultURL to the waiting check.
+// It reports false if no check waits for the result, e.g. the query was not sent by the checker.
+func (c *StatusChecker) HandleResult(cb *TransactionStatusCallback) bool {
+	key := referenceOccasion(cb.Result.ReferenceData.ReferenceItem)
+	c.mu.Lock()
+	results, ok := c.pending[key]
+	if ok {
+		delete(c.pending, key)
+	}
+	c.mu.Unlock()
+	if ok {
+		results <- cb
+	}
+	return ok
+}
+
+// transactionNotFound reports whether ResultDesc of the failed query means that M-Pesa has no such transaction.
+func transactionNotFound(desc string) bool {
+	desc = strings.ToLower(desc)
+	return strings.Contains(desc, "does not exist") || strings.Contains(desc, "not found")
+}
@@ -0,0 +1,59 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestParseAmount(t *testing.T) {
+	for s, cents := range map[string]int64{
+		"10":        1000,
+		"10.5":      1050,
+		"10.05":     1005,
+		"1,000.00":  100000,
+		" 0.01 ":    1,
+		"-10.50":    -1050,
+		"1,234,567": 123456700,
+	} {
+		a, err := mpesa.ParseAmount(s)
+		assert.NilError(t, err, s)
+		assert.Equal(t, a.Cents(), cents, s)
+	}
+	for _, s := range []string{
+		"", "abc", "10.001", "1/2", "-", "1.", ".5", "+1", "--1",
+		"0x10", "0b11", "0o7", "1_000",
+		"1e2", "1E2", "1e400000",
+		"1,0,0", ",100", "100,", "1,00", "1000,000", "1,000,00",
+		"99999999999999999999",
+	} {
+		_, err := mpesa.ParseAmount(s)
+		assert.Assert(t, err != nil, s)
+	}
+	assert.Equal(t, mpesa.AmountFromCents(1005).String(), "10.05")
+	assert.Equal(t, mpesa.KES(10).String(), "10")
+	assert.Equal(t, mpesa.KES(10).StringFixed(), "10.00")
+}
+
+func TestAmount_JSON(t *testing.T) {
+	data, err := mpesa.Marshal(mpesa.Payment{Amount: mpesa.KES(1)})
+	assert.NilError(t, err)
+	var fields map[string]json.RawMessage
+	assert.NilError(t, json.Unmarshal(data, &fields))
+	assert.Equal(t, string(fields["Amount"]), "1")
+
+	var c2b mpesa.C2B
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"Amount":"10.50"}`), &c2b))
+	assert.Equal(t, c2b.Amount, mpesa.AmountFromCents(1050))
+	for _, data := range []string{`"0x10"`, `"0b11"`, `1e2`, `"1,000"`, `"1,0,0"`} {
+		assert.Assert(t, mpesa.Unmarshal([]byte(`{"Amount":`+data+`}`), &c2b) != nil, data)
+	}
+
+	var cb mpesa.PaymentCallback
+	assert.NilError(t, mpesa.Unmarshal(paymentCallbackJSON, &cb))
+	amount, err := cb.Amount()
+	assert.NilError(t, err)
+	assert.Equal(t, amount, mpesa.KES(1))
+}
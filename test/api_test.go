@@ -34,7 +34,7 @@ const (
 	mpesaOnlinePasskey    = "bfb279f9aa9bdbcf158e97dd71a467cd2e0c893059b10f78e6b72ada1ed2c919"
 	initiatorSecurityCred = "EA88UFQE6V9G2HkmuubuLpj/CdySZm1q9YtrXFV0KXw7gSNQONvWR9YhrQin595gpid92PvwatjpOopq+L6sEEi4HdtrfYBEgvW+HUgKMhSrJonl29nunu/t6NbMOiuvUFZ5NYxo1vsLOnAK4useJCTZPFCHCP8TTAE8SLWjOS6fsZcNkhMMU6YHUJq4ptKYWDvW/+EkfHYM/SEJPnZtPhZ6XhnGsQOBTHfpn+XmLz6PAK7L2Y1FvEKJP62Jo7+JIdtxNXVIyV1OVg4kwnoDZv9kF/GdW0tjBRwfaow6VPMuh2e7SKYJT36dm5PznbX/1liI/6z08fcwJrNUV0RMnA=="
 
-	callbackUrl = "http://google.com:80/somepath"
+	callbackUrl = "https://google.com:443/somepath"
 )
 
 func TestService_GenerateNewAccessToken(t *testing.T) {
@@ -47,8 +47,8 @@ func TestService_B2CRequest(t *testing.T) {
 	paymentResp, err := testMPESAService.B2CRequest(mpesa.B2C{
 		InitiatorName:      initiatorName,
 		SecurityCredential: initiatorSecurityCred,
-		CommandID:          "PromotionPayment",
-		Amount:             "10",
+		CommandID:          mpesa.CommandPromotionPayment,
+		Amount:             mpesa.KES(10),
 		PartyA:             shortCode1,
 		PartyB:             testMSISDN,
 		Remarks:            "auto-testing",
@@ -73,8 +73,8 @@ func TestService_MPESAOnlinePayment(t *testing.T) {
 		BusinessShortCode: mpesaOnlineShortcode,
 		Password:          password.String(),
 		Timestamp:         mpesa.Timestamp(time.Now()),
-		TransactionType:   "CustomerPayBillOnline",
-		Amount:            "1",
+		TransactionType:   mpesa.CommandCustomerPayBillOnline,
+		Amount:            mpesa.KES(1),
 		PartyA:            testMSISDN,
 		PartyB:            mpesaOnlineShortcode,
 		PhoneNumber:       testMSISDN,
@@ -95,8 +95,8 @@ func TestService_MPESAOnlinePayment(t *testing.T) {
 func TestService_C2BSimulation(t *testing.T) {
 	paymentResp, err := testMPESAService.C2BSimulation(mpesa.C2B{
 		ShortCode:     shortCode1,
-		CommandID:     "CustomerPayBillOnline",
-		Amount:        "10",
+		CommandID:     mpesa.CommandCustomerPayBillOnline,
+		Amount:        mpesa.KES(10),
 		Msisdn:        testMSISDN,
 		BillRefNumber: "auto-testing",
 	})
@@ -111,7 +111,7 @@ func TestService_C2BSimulation(t *testing.T) {
 func TestService_C2BRegisterURL(t *testing.T) {
 	paymentResp, err := testMPESAService.C2BRegisterURL(mpesa.C2BRegisterURL{
 		ShortCode:       shortCode1,
-		ResponseType:    "Cancelled",
+		ResponseType:    mpesa.ResponseCancelled,
 		ConfirmationURL: callbackUrl,
 		ValidationURL:   callbackUrl,
 	})
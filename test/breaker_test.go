@@ -0,0 +1,44 @@
+package test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var calls int32
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithRetryPolicy(mpesa.RetryPolicy{}),
+		mpesa.WithCircuitBreaker(mpesa.CircuitBreakerSettings{
+			FailureThreshold: 2,
+			OpenTimeout:      50 * time.Millisecond,
+		}),
+	)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.TransactionStatus(mpesa.TransactionStatus{})
+		assert.Assert(t, err != nil)
+	}
+	assert.Equal(t, s.CircuitState(mpesa.APITransactionStatus), mpesa.CircuitOpen)
+	_, err := s.TransactionStatus(mpesa.TransactionStatus{})
+	assert.Equal(t, err, mpesa.ErrCircuitOpen)
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(2))
+
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, s.CircuitState(mpesa.APITransactionStatus), mpesa.CircuitHalfOpen)
+	_, err = s.TransactionStatus(mpesa.TransactionStatus{})
+	assert.NilError(t, err)
+	assert.Equal(t, s.CircuitState(mpesa.APITransactionStatus), mpesa.CircuitClosed)
+}
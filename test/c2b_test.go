@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func TestC2BReply(t *testing.T) {
+	data, err := mpesa.Marshal(mpesa.C2BAccept())
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"ResultCode":"0","ResultDesc":"Accepted"}`)
+
+	reply := mpesa.C2BReject(mpesa.C2BRejectInvalidAccountNumber)
+	assert.Assert(t, !reply.Accepted())
+	data, err = mpesa.Marshal(reply)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `{"ResultCode":"C2B00012","ResultDesc":"Rejected"}`)
+}
+
+func TestC2BValidationRequest(t *testing.T) {
+	var req mpesa.C2BValidationRequest
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"TransactionType":"Pay Bill","TransID":"RKTQDM7W6S","TransTime":"20191122063845","TransAmount":"10","BusinessShortCode":"600638","BillRefNumber":"invoice008","MSISDN":"25470****149","FirstName":"John"}`), &req))
+	assert.Equal(t, req.TransID, "RKTQDM7W6S")
+	assert.Equal(t, req.BillRefNumber, "invoice008")
+
+	// Deprecated names are aliases.
+	var old mpesa.C2BValidationResponse = req
+	assert.Equal(t, old.FirstName, "John")
+}
+
+func TestMSISDNResolver(t *testing.T) {
+	ctx := context.Background()
+	customer := mpesa.MSISDN(testMSISDN)
+	resolver := mpesa.NewMSISDNResolver(mpesa.NewMSISDNIndex(customer))
+
+	hash := mpesa.HashMSISDN(customer)
+	assert.Assert(t, mpesa.IsHashedMSISDN(hash))
+	assert.Assert(t, !mpesa.IsHashedMSISDN(testMSISDN))
+
+	m, err := resolver.Resolve(ctx, strings.ToUpper(hash))
+	assert.NilError(t, err)
+	assert.Equal(t, m, customer)
+
+	m, err = resolver.Resolve(ctx, "0708374149")
+	assert.NilError(t, err)
+	assert.Equal(t, m, customer)
+
+	_, err = resolver.Resolve(ctx, mpesa.HashMSISDN("254712345678"))
+	assert.Assert(t, errors.Is(err, mpesa.ErrUnknownMSISDN))
+
+	// Numbers are indexed in the canonical form, which M-Pesa hashes.
+	idx := mpesa.NewMSISDNIndex("0712 345 678")
+	m, err = mpesa.NewMSISDNResolver(idx).Resolve(ctx, mpesa.HashMSISDN("254712345678"))
+	assert.NilError(t, err)
+	assert.Equal(t, m, mpesa.MSISDN("254712345678"))
+	idx.Remove("+254712345678")
+	assert.Equal(t, idx.Len(), 0)
+
+	_, err = mpesa.NewMSISDNResolver(nil).Resolve(ctx, hash)
+	assert.ErrorContains(t, err, "no phone directory")
+	m, err = mpesa.NewMSISDNResolver(nil).Resolve(ctx, "0708374149")
+	assert.NilError(t, err)
+	assert.Equal(t, m, customer)
+}
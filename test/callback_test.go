@@ -0,0 +1,98 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func TestPaymentCallback_Metadata(t *testing.T) {
+	var cb mpesa.PaymentCallback
+	assert.NilError(t, mpesa.Unmarshal(paymentCallbackJSON, &cb))
+	md, err := cb.Metadata()
+	assert.NilError(t, err)
+	assert.Equal(t, md.Amount, mpesa.KES(1))
+	assert.Equal(t, md.MpesaReceiptNumber, "LGR7OWQX0R")
+	assert.Equal(t, md.Balance, mpesa.Amount(0))
+	assert.Equal(t, md.PhoneNumber, mpesa.MSISDN("254721566839"))
+	assert.Assert(t, md.TransactionDate.Equal(time.Date(2017, 7, 27, 12, 48, 0, 0, time.UTC)), md.TransactionDate)
+
+	var failed mpesa.PaymentCallback
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"Body":{"stkCallback":{"ResultCode":1032,"ResultDesc":"Request cancelled by user"}}}`), &failed))
+	_, err = failed.Metadata()
+	assert.Assert(t, errors.Is(err, mpesa.ErrMissingItem))
+	assert.ErrorContains(t, err, "Amount")
+}
+
+var b2cCallbackJSON = []byte(`{"Result":{"ResultType":0,"ResultCode":0,"ResultDesc":"The service request is processed successfully.","OriginatorConversationID":"10571-7910404-1","ConversationID":"AG_20191219_00004e48cf7e3533f581","TransactionID":"NLJ41HAY6Q","ResultParameters":{"ResultParameter":[{"Key":"TransactionAmount","Value":10},{"Key":"TransactionReceipt","Value":"NLJ41HAY6Q"},{"Key":"B2CRecipientIsRegisteredCustomer","Value":"Y"},{"Key":"B2CChargesPaidAccountAvailableFunds","Value":-4510.00},{"Key":"ReceiverPartyPublicName","Value":"254708374149 - John Doe"},{"Key":"TransactionCompletedDateTime","Value":"19.12.2019 11:45:50"},{"Key":"B2CUtilityAccountAvailableFunds","Value":10116.00},{"Key":"B2CWorkingAccountAvailableFunds","Value":900000.00}]},"ReferenceData":{"ReferenceItem":{"Key":"QueueTimeoutURL","Value":"https://internalsandbox.safaricom.co.ke/mpesa/b2cresults/v1/submit"}}}}`)
+
+func TestB2CCallback_Parameters(t *testing.T) {
+	var cb mpesa.B2CCallback
+	assert.NilError(t, mpesa.Unmarshal(b2cCallbackJSON, &cb))
+	p, err := cb.Parameters()
+	assert.NilError(t, err)
+	assert.Equal(t, p.TransactionAmount, mpesa.KES(10))
+	assert.Equal(t, p.TransactionReceipt, "NLJ41HAY6Q")
+	assert.Equal(t, p.RecipientIsRegisteredCustomer, true)
+	assert.Equal(t, p.ChargesPaidAccountAvailableFunds, mpesa.KES(-4510))
+	assert.Equal(t, p.ReceiverPhone, mpesa.MSISDN("254708374149"))
+	assert.Equal(t, p.ReceiverName, "John Doe")
+	assert.Assert(t, p.TransactionCompletedDateTime.Equal(time.Date(2019, 12, 19, 8, 45, 50, 0, time.UTC)), p.TransactionCompletedDateTime)
+	assert.Equal(t, p.UtilityAccountAvailableFunds, mpesa.KES(10116))
+	assert.Equal(t, p.WorkingAccountAvailableFunds, mpesa.KES(900000))
+
+	cb.Result.ResultParameters.ResultParameter = cb.Result.ResultParameters.ResultParameter[:1]
+	_, err = cb.Parameters()
+	assert.Assert(t, errors.Is(err, mpesa.ErrMissingItem))
+	assert.ErrorContains(t, err, "TransactionReceipt")
+}
+
+func TestReversalResponse_Parameters(t *testing.T) {
+	var r mpesa.ReversalResponse
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"Result":{"ResultType":0,"ResultCode":0,"ResultDesc":"The service request is processed successfully.","OriginatorConversationID":"8521-4298025-1","ConversationID":"AG_20181005_00004d7ee675c0c7ee0b","TransactionID":"MJ561H6X5O","ResultParameters":{"ResultParameter":[{"Key":"DebitAccountBalance","Value":"Utility Account|KES|51661.00|51661.00|0.00|0.00"},{"Key":"Amount","Value":100},{"Key":"TransCompletedTime","Value":20181005153225},{"Key":"OriginalTransactionID","Value":"MJ551H6X5D"},{"Key":"Charge","Value":0},{"Key":"CreditPartyPublicName","Value":"254708374149 - John Doe"},{"Key":"DebitPartyPublicName","Value":"601315 - Safaricom1338"}]},"ReferenceData":{"ReferenceItem":{"Key":"QueueTimeoutURL","Value":"https://internalsandbox.safaricom.co.ke/mpesa/reversalresults/v1/submit"}}}}`), &r))
+	p, err := r.Parameters()
+	assert.NilError(t, err)
+	assert.Equal(t, p.Amount, mpesa.KES(100))
+	assert.Equal(t, p.OriginalTransactionID, "MJ551H6X5D")
+	assert.Equal(t, p.Charge, mpesa.Amount(0))
+	assert.Assert(t, p.TransCompletedTime.Equal(time.Date(2018, 10, 5, 12, 32, 25, 0, time.UTC)), p.TransCompletedTime)
+	assert.Equal(t, p.DebitAccountBalance, "Utility Account|KES|51661.00|51661.00|0.00|0.00")
+	assert.Equal(t, p.CreditPartyPublicName, "254708374149 - John Doe")
+	assert.Equal(t, p.DebitPartyPublicName, "601315 - Safaricom1338")
+
+	var failed mpesa.ReversalResponse
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"Result":{"ResultType":0,"ResultCode":2001,"ResultDesc":"The initiator information is invalid."}}`), &failed))
+	_, err = failed.Parameters()
+	assert.Assert(t, errors.Is(err, mpesa.ErrMissingItem))
+}
+
+func TestResultParameters_Decode(t *testing.T) {
+	var cb mpesa.B2CCallback
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"Result":{"ResultParameters":{"ResultParameter":{"Key":"TransactionAmount","Value":"10.50"}}}}`), &cb))
+	assert.Equal(t, len(cb.Result.ResultParameters.ResultParameter), 1)
+
+	var result struct {
+		Amount    mpesa.Amount `mpesa:"TransactionAmount"`
+		Cents     int64        `mpesa:"Cents"`
+		Completed time.Time    `mpesa:"Completed"`
+		Charges   float64      `mpesa:"Charges,optional"`
+		Skipped   string
+	}
+	params := mpesa.ResultParameters{
+		{Key: "TransactionAmount", Value: []byte(`10.5`)},
+		{Key: "Cents", Value: []byte(`"1050"`)},
+		{Key: "Completed", Value: []byte(`20191219102115`)},
+	}
+	assert.NilError(t, params.Decode(&result))
+	assert.Equal(t, result.Amount, mpesa.AmountFromCents(1050))
+	assert.Equal(t, result.Cents, int64(1050))
+	assert.Assert(t, result.Completed.Equal(time.Date(2019, 12, 19, 7, 21, 15, 0, time.UTC)), result.Completed)
+
+	err := params[:1].Decode(&result)
+	assert.Assert(t, errors.Is(err, mpesa.ErrMissingItem))
+	assert.ErrorContains(t, err, "Cents")
+	assert.ErrorContains(t, params.Decode(result), "pointer to struct is expected")
+}
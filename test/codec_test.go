@@ -0,0 +1,132 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+var paymentCallbackJSON = []byte(`{"Body":{"stkCallback":{"MerchantRequestID":"19465-780693-1","CheckoutRequestID":"ws_CO_27072017154747416","ResultCode":0,"ResultDesc":"The service request is processed successfully.","CallbackMetadata":{"Item":[{"Name":"Amount","Value":1},{"Name":"MpesaReceiptNumber","Value":"LGR7OWQX0R"},{"Name":"Balance"},{"Name":"TransactionDate","Value":20170727154800},{"Name":"PhoneNumber","Value":254721566839}]}}}}`)
+
+var b2cRequest = mpesa.B2C{
+	InitiatorName:      initiatorName,
+	SecurityCredential: initiatorSecurityCred,
+	CommandID:          "BusinessPayment",
+	Amount:             mpesa.KES(10),
+	PartyA:             shortCode1,
+	PartyB:             testMSISDN,
+	Remarks:            "payout",
+	QueueTimeOutURL:    callbackUrl,
+	ResultURL:          callbackUrl,
+}
+
+func TestDecode_PaymentCallback(t *testing.T) {
+	var viaCodec, viaJSON mpesa.PaymentCallback
+	assert.NilError(t, mpesa.Decode(bytes.NewReader(paymentCallbackJSON), &viaCodec))
+	assert.NilError(t, json.Unmarshal(paymentCallbackJSON, &viaJSON))
+	assert.DeepEqual(t, viaCodec, viaJSON)
+}
+
+func BenchmarkUnmarshal_PaymentCallback_EncodingJSON(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var cb mpesa.PaymentCallback
+		if err := json.Unmarshal(paymentCallbackJSON, &cb); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshal_PaymentCallback_EasyJSON(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var cb mpesa.PaymentCallback
+		if err := mpesa.Unmarshal(paymentCallbackJSON, &cb); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecode_PaymentCallback_EncodingJSON(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var cb mpesa.PaymentCallback
+		if err := json.NewDecoder(bytes.NewReader(paymentCallbackJSON)).Decode(&cb); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecode_PaymentCallback_EasyJSON(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var cb mpesa.PaymentCallback
+		if err := mpesa.Decode(bytes.NewReader(paymentCallbackJSON), &cb); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshal_B2C_EncodingJSON(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(b2cRequest); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkMarshal_B2C_EasyJSON(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := mpesa.Marshal(b2cRequest); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// staticTransport answers every request with the OAuth token or the body without network.
+type staticTransport struct {
+	body []byte
+}
+
+func (t staticTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	body := t.body
+	if strings.HasPrefix(r.URL.Path, "/oauth/") {
+		body = []byte(`{"access_token":"test-token","expires_in":"3599"}`)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    r,
+	}, nil
+}
+
+func benchmarkB2CRequest(b *testing.B, policy mpesa.DecodePolicy) {
+	client := &http.Client{Transport: staticTransport{
+		body: []byte(`{"OriginatorConversationID":"10571-7910404-1","ConversationID":"AG_20191219_00004e48cf7e3533f581","ResponseCode":"0","ResponseDescription":"Accept the service request successfully."}`),
+	}}
+	s := mpesa.New("key", "secret", "http://daraja.test/", mpesa.WithHTTPClient(client), mpesa.WithDecodePolicy(policy))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.B2CRequest(b2cRequest); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkB2CRequest_DecodeStrict(b *testing.B) {
+	benchmarkB2CRequest(b, mpesa.DecodeStrict)
+}
+
+func BenchmarkB2CRequest_DecodeLenient(b *testing.B) {
+	benchmarkB2CRequest(b, mpesa.DecodeLenient)
+}
@@ -0,0 +1,76 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestCorrelation_URL(t *testing.T) {
+	var sent mpesa.B2C
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.NilError(t, json.Unmarshal(body, &sent))
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/")
+	_, err := s.B2CRequest(b2cRequest, mpesa.WithCorrelation(mpesa.Correlation{"order": "42", "tenant": "a b"}, mpesa.CorrelateURL))
+	assert.NilError(t, err)
+	assert.Equal(t, sent.ResultURL, callbackUrl+"?mpesa_correlation=~order%3D42%26tenant%3Da%2Bb")
+	assert.Equal(t, sent.QueueTimeOutURL, sent.ResultURL)
+
+	r := httptest.NewRequest(http.MethodPost, sent.ResultURL, bytes.NewReader(b2cCallbackJSON))
+	var cb mpesa.B2CCallback
+	c, err := mpesa.DecodeCallback(r, &cb)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, c, mpesa.Correlation{"order": "42", "tenant": "a b"})
+
+	// ReferenceItem is a single object in this callback.
+	url, ok := cb.Result.ReferenceData.ReferenceItem.Get("QueueTimeoutURL")
+	assert.Assert(t, ok)
+	assert.Equal(t, string(url), `"https://internalsandbox.safaricom.co.ke/mpesa/b2cresults/v1/submit"`)
+}
+
+func TestCorrelation_Carriers(t *testing.T) {
+	var sent mpesa.Reversal
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.NilError(t, json.Unmarshal(body, &sent))
+		w.Write([]byte(`{"OriginatorConversationID":"8521-4298025-1","ConversationID":"AG_20181005_00004d7ee675c0c7ee0b","ResponseCode":"0","ResponseDescription":"Accept the service request successfully."}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/")
+	_, err := s.Reversal(mpesa.Reversal{}, mpesa.WithCorrelation(mpesa.Correlation{"order": "42"}, mpesa.CorrelateOccasion))
+	assert.NilError(t, err)
+	assert.Equal(t, sent.Occasion, "~order=42")
+
+	_, err = s.Reversal(mpesa.Reversal{Occasion: "refund"}, mpesa.WithCorrelation(mpesa.Correlation{"order": "42"}, mpesa.CorrelateOccasion))
+	assert.ErrorContains(t, err, "Occasion is already set")
+	_, err = s.C2BRegisterURL(mpesa.C2BRegisterURL{}, mpesa.WithCorrelation(mpesa.Correlation{"order": "42"}, mpesa.CorrelateURL))
+	assert.ErrorContains(t, err, "not supported")
+
+	var result mpesa.ReversalResponse
+	r := httptest.NewRequest(http.MethodPost, "/result", bytes.NewReader([]byte(`{"Result":{"ResultCode":0,"ReferenceData":{"ReferenceItem":[{"Key":"QueueTimeoutURL","Value":"https://example.com"},{"Key":"Occasion","Value":"~order=42"}]}}}`)))
+	c, err := mpesa.DecodeCallback(r, &result)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, c, mpesa.Correlation{"order": "42"})
+
+	var confirmation mpesa.C2BConfirmationRequest
+	r = httptest.NewRequest(http.MethodPost, "/confirmation", bytes.NewReader([]byte(`{"TransID":"RKTQDM7W6S","BillRefNumber":"~order=42"}`)))
+	c, err = mpesa.DecodeCallback(r, &confirmation)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, c, mpesa.Correlation{"order": "42"})
+
+	// Account references entered by customers are not mistaken for the metadata.
+	for _, ref := range []string{"invoice008", "INV=12"} {
+		r = httptest.NewRequest(http.MethodPost, "/confirmation", bytes.NewReader([]byte(`{"TransID":"RKTQDM7W6S","BillRefNumber":"`+ref+`"}`)))
+		c, err = mpesa.DecodeCallback(r, &confirmation)
+		assert.NilError(t, err)
+		assert.Assert(t, c == nil, ref)
+	}
+}
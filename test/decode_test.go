@@ -0,0 +1,39 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestDecodePolicy(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0","NewField":1}`))
+	})
+
+	strict := mpesa.New("key", "secret", srv.URL+"/")
+	_, err := strict.B2CRequest(mpesa.B2C{})
+	assert.ErrorContains(t, err, "unknown fields NewField")
+
+	var reported []string
+	lenient := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithDecodePolicy(mpesa.DecodeLenientReport),
+		mpesa.WithUnknownFieldsHook(func(api mpesa.API, fields []string) {
+			assert.Equal(t, api, mpesa.APIB2C)
+			reported = append(reported, fields...)
+		}),
+	)
+	resp, err := lenient.B2CRequest(mpesa.B2C{})
+	assert.NilError(t, err)
+	assert.Equal(t, resp.ConversationID, "AG_1")
+	assert.DeepEqual(t, reported, []string{"NewField"})
+}
+
+func TestUnknownFields_Nested(t *testing.T) {
+	data := []byte(`{"Body":{"stkCallback":{"ResultCode":0,"Extra":"x","CallbackMetadata":{"Item":[{"Name":"Amount","Value":1,"Type":"n"}]}}}}`)
+	fields, err := mpesa.UnknownFields(data, &mpesa.PaymentCallback{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, fields, []string{"Body.stkCallback.CallbackMetadata.Item.Type", "Body.stkCallback.Extra"})
+}
@@ -0,0 +1,39 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestCommandID_ValidFor(t *testing.T) {
+	assert.Assert(t, mpesa.CommandBusinessPayment.ValidFor(mpesa.APIB2C))
+	assert.Assert(t, mpesa.CommandCustomerBuyGoodsOnline.ValidFor(mpesa.APISTKPush))
+	assert.Assert(t, !mpesa.CommandCustomerPayBillOnline.ValidFor(mpesa.APIB2C))
+	assert.Assert(t, !mpesa.CommandAccountBalance.ValidFor(mpesa.APIReversal))
+
+	err := mpesa.Reversal{
+		CommandID:              mpesa.CommandTransactionReversal,
+		ReceiverIdentifierType: mpesa.IdentifierMSISDN,
+	}.Validate()
+	assert.ErrorContains(t, err, "ReceiverIdentifierType: must be one of 4, 11, got 1")
+	err = mpesa.C2BRegisterURL{ResponseType: "Complete"}.Validate()
+	assert.ErrorContains(t, err, `ResponseType: must be one of Completed, Cancelled, got "Complete"`)
+}
+
+func TestIdentifierType_JSON(t *testing.T) {
+	data, err := mpesa.Marshal(mpesa.TransactionStatus{IdentifierType: mpesa.IdentifierShortCode})
+	assert.NilError(t, err)
+	var fields map[string]json.RawMessage
+	assert.NilError(t, json.Unmarshal(data, &fields))
+	assert.Equal(t, string(fields["IdentifierType"]), `"4"`)
+
+	var status mpesa.TransactionStatus
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"IdentifierType":"2"}`), &status))
+	assert.Equal(t, status.IdentifierType, mpesa.IdentifierTill)
+	assert.NilError(t, json.Unmarshal([]byte(`{"IdentifierType":11}`), &status))
+	assert.Equal(t, status.IdentifierType, mpesa.IdentifierOrganization)
+	assert.Assert(t, mpesa.Unmarshal([]byte(`{"IdentifierType":"x"}`), &status) != nil)
+}
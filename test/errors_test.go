@@ -0,0 +1,109 @@
+package test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func TestAPIError_Taxonomy(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mpesa/b2c/v1/paymentrequest":
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"requestId":"r-1","errorCode":"500.003.02","errorMessage":"Spike arrest violation"}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`<html>Bad Gateway</html>`))
+		}
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithRetryPolicy(mpesa.RetryPolicy{}))
+
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.Assert(t, errors.Is(err, mpesa.ErrSpikeArrest))
+	assert.Assert(t, mpesa.IsRetryable(err))
+	var apiErr mpesa.APIError
+	assert.Assert(t, errors.As(err, &apiErr))
+	assert.Equal(t, apiErr.StatusCode, http.StatusTooManyRequests)
+	assert.Equal(t, apiErr.Header.Get("Retry-After"), "1")
+	assert.Equal(t, apiErr.Code(), "500.003.02")
+
+	_, err = s.TransactionStatus(mpesa.TransactionStatus{})
+	assert.Assert(t, errors.Is(err, mpesa.ErrUnexpectedResponse))
+	assert.Equal(t, mpesa.ErrorClassOf(err), mpesa.ErrorClassRetryable)
+	assert.Assert(t, errors.As(err, &apiErr))
+	assert.Equal(t, string(apiErr.Body), `<html>Bad Gateway</html>`)
+}
+
+func TestStrictResponseCode(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"MerchantRequestID":"m-1","CheckoutRequestID":"ws_CO_1","ResponseCode":"1","ResponseDescription":"Rejected"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithStrictResponseCode(true))
+
+	resp, err := s.MPESAOnlinePayment(mpesa.Payment{})
+	assert.Assert(t, resp == nil)
+	assert.Assert(t, errors.Is(err, mpesa.ErrNotAccepted))
+	var codeErr *mpesa.ResponseCodeError
+	assert.Assert(t, errors.As(err, &codeErr))
+	assert.Equal(t, codeErr.ResponseCode, "1")
+	assert.Equal(t, codeErr.Response.(*mpesa.PaymentResponse).CheckoutRequestID, "ws_CO_1")
+}
+
+func TestStrictResponseCode_Reversal(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		var reversal mpesa.Reversal
+		assert.Check(t, mpesa.Decode(r.Body, &reversal))
+		code := "0"
+		if reversal.Remarks == "reject" {
+			code = "1"
+		}
+		w.Write([]byte(`{"OriginatorConversationID":"8521-4298025-1","ConversationID":"AG_20181005_00004d7ee675c0c7ee0b","ResponseCode":"` + code + `","ResponseDescription":"Accept the service request successfully."}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithStrictResponseCode(true))
+
+	ack, err := s.Reversal(mpesa.Reversal{})
+	assert.NilError(t, err)
+	assert.Equal(t, ack.ConversationID, "AG_20181005_00004d7ee675c0c7ee0b")
+
+	_, err = s.Reversal(mpesa.Reversal{Remarks: "reject"})
+	assert.Assert(t, errors.Is(err, mpesa.ErrNotAccepted))
+	var codeErr *mpesa.ResponseCodeError
+	assert.Assert(t, errors.As(err, &codeErr))
+	assert.Equal(t, codeErr.API, mpesa.APIReversal)
+}
+
+func TestAPIError_ServerErrorMessages(t *testing.T) {
+	for msg, class := range map[string]mpesa.ErrorClass{
+		"Merchant does not exist": mpesa.ErrorClassPermanent,
+		"Wrong credentials":       mpesa.ErrorClassAuth,
+		"Unable to lock subscriber, a transaction is already in process for the current subscriber": mpesa.ErrorClassRetryable,
+		"Something new": mpesa.ErrorClassUnknown,
+	} {
+		code := "500.001.1001"
+		err := mpesa.APIError{ErrorCode: &code, ErrorMessage: &msg, StatusCode: http.StatusInternalServerError}
+		assert.Equal(t, err.Class(), class, msg)
+		assert.Assert(t, errors.Is(err, mpesa.ErrServerError), msg)
+	}
+
+	var calls int32
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"requestId":"r-1","errorCode":"500.001.1001","errorMessage":"Merchant does not exist"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithRetryPolicy(fastRetries),
+		mpesa.WithCircuitBreaker(mpesa.CircuitBreakerSettings{FailureThreshold: 1, OpenTimeout: time.Minute}),
+	)
+	_, err := s.TransactionStatus(mpesa.TransactionStatus{})
+	assert.Equal(t, mpesa.ErrorClassOf(err), mpesa.ErrorClassPermanent)
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(1))
+	assert.Equal(t, s.CircuitState(mpesa.APITransactionStatus), mpesa.CircuitClosed)
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func TestInterceptor(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Header.Get("X-Trace-Id"), "trace-1")
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	var exchanges []*mpesa.Exchange
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithInterceptor(mpesa.InterceptorFuncs{
+		Before: func(ex *mpesa.Exchange) error {
+			ex.Request.Header.Set("X-Trace-Id", "trace-1")
+			return nil
+		},
+		After: func(ex *mpesa.Exchange) error {
+			exchanges = append(exchanges, ex)
+			return nil
+		},
+	}))
+
+	_, err := s.B2CRequest(mpesa.B2C{PartyA: shortCode1})
+	assert.NilError(t, err)
+	assert.Equal(t, len(exchanges), 2)
+	assert.Equal(t, exchanges[0].API, mpesa.APIOAuth)
+
+	ex := exchanges[1]
+	assert.Equal(t, ex.API, mpesa.APIB2C)
+	assert.Equal(t, ex.ShortCode, shortCode1)
+	assert.Equal(t, ex.Response.StatusCode, http.StatusOK)
+	assert.Equal(t, string(ex.ResponseBody), `{"ConversationID":"AG_1","ResponseCode":"0"}`)
+	assert.Equal(t, ex.Output.(*mpesa.B2CResponse).ConversationID, "AG_1")
+}
+
+func TestInterceptor_Cleanup(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	var calls []string
+	record := func(name string, beforeErr, afterErr error) mpesa.Interceptor {
+		return mpesa.InterceptorFuncs{
+			Before: func(ex *mpesa.Exchange) error {
+				if ex.API == mpesa.APIB2C {
+					calls = append(calls, name+".before")
+					return beforeErr
+				}
+				return nil
+			},
+			After: func(ex *mpesa.Exchange) error {
+				if ex.API == mpesa.APIB2C {
+					calls = append(calls, name+".after")
+					return afterErr
+				}
+				return nil
+			},
+		}
+	}
+
+	failed := errors.New("before failed")
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithLogLevel(mpesa.LogOff), mpesa.WithInterceptor(
+		record("a", nil, nil),
+		record("b", failed, nil),
+		record("c", nil, nil),
+	))
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.Assert(t, errors.Is(err, failed))
+	assert.DeepEqual(t, calls, []string{"a.before", "b.before", "a.after"})
+
+	calls = nil
+	s = mpesa.New("key", "secret", srv.URL+"/", mpesa.WithLogLevel(mpesa.LogOff), mpesa.WithInterceptor(
+		record("a", nil, nil),
+		record("b", nil, errors.New("after failed")),
+		record("c", nil, nil),
+	))
+	_, err = s.B2CRequest(mpesa.B2C{})
+	assert.ErrorContains(t, err, "after failed")
+	assert.DeepEqual(t, calls, []string{"a.before", "b.before", "c.before", "c.after", "b.after", "a.after"})
+}
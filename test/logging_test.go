@@ -0,0 +1,39 @@
+package test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestLogging_Redaction(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	var buf bytes.Buffer
+	s := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithLogger(slog.New(slog.NewJSONHandler(&buf, nil))),
+		mpesa.WithLogLevel(mpesa.LogFull),
+		mpesa.WithMSISDNMasking(true),
+	)
+
+	_, err := s.B2CRequest(mpesa.B2C{
+		SecurityCredential: initiatorSecurityCred,
+		PartyA:             shortCode1,
+		PartyB:             testMSISDN,
+	})
+	assert.NilError(t, err)
+
+	logs := buf.String()
+	assert.Assert(t, strings.Count(logs, "mpesa: exchange") == 2, logs)
+	for _, secret := range []string{initiatorSecurityCred, "test-token", "Basic a2V5", testMSISDN} {
+		assert.Assert(t, !strings.Contains(logs, secret), "%q is logged", secret)
+	}
+	assert.Assert(t, strings.Contains(logs, "254******149"), logs)
+	assert.Assert(t, strings.Contains(logs, shortCode1), logs)
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func TestResponseMeta(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Test", "1")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"requestId":"11728-2929992-1","errorCode":"400.002.02","errorMessage":"Bad Request - Invalid Amount"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/")
+
+	var meta mpesa.ResponseMeta
+	_, err := s.B2CRequest(mpesa.B2C{}, mpesa.WithResponseMeta(&meta))
+	assert.Assert(t, errors.Is(err, mpesa.ErrInvalidRequest))
+	assert.Equal(t, meta.StatusCode, http.StatusBadRequest)
+	assert.Equal(t, meta.Header.Get("X-Test"), "1")
+	assert.Equal(t, meta.RequestID, "11728-2929992-1")
+	assert.Equal(t, meta.Attempts, 1)
+	assert.Assert(t, len(meta.Body) > 0)
+
+	// The call fails validation before sending the request and does not keep the data of the previous call.
+	_, err = s.B2CRequest(mpesa.B2C{PartyB: "12345"}, mpesa.WithResponseMeta(&meta))
+	var verr mpesa.ValidationError
+	assert.Assert(t, errors.As(err, &verr))
+	assert.DeepEqual(t, meta, mpesa.ResponseMeta{})
+}
+
+func TestResponseMeta_TokenRefresh(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	var refreshes int
+	clock := &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithClock(clock),
+		mpesa.WithRateLimit(mpesa.APIB2C, mpesa.RateLimit{Rate: 1.0 / (24 * 3600), Burst: 1}),
+		mpesa.WithRateLimitFailFast(true),
+		mpesa.WithInterceptor(mpesa.InterceptorFuncs{After: func(ex *mpesa.Exchange) error {
+			if ex.API == mpesa.APIOAuth {
+				refreshes++
+			}
+			return nil
+		}}),
+	)
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.NilError(t, err)
+
+	// The token expires, it is refreshed, then the call is rate limited before sending the request.
+	clock.Advance(2 * time.Hour)
+	var meta mpesa.ResponseMeta
+	_, err = s.B2CRequest(mpesa.B2C{}, mpesa.WithResponseMeta(&meta))
+	assert.Equal(t, err, mpesa.ErrRateLimited)
+	assert.Equal(t, refreshes, 2)
+	assert.DeepEqual(t, meta, mpesa.ResponseMeta{})
+}
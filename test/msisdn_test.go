@@ -0,0 +1,65 @@
+package test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func TestParseMSISDN(t *testing.T) {
+	for _, s := range []string{
+		"0712345678",
+		"712345678",
+		"254712345678",
+		"+254712345678",
+		"+254 712 345 678",
+		"0712-345-678",
+		"00254712345678",
+	} {
+		m, err := mpesa.ParseMSISDN(s)
+		assert.NilError(t, err, s)
+		assert.Equal(t, m.String(), "254712345678", s)
+	}
+	m, err := mpesa.ParseMSISDN("0110345678")
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "254110345678")
+	assert.Equal(t, m.Local(), "0110345678")
+	assert.Equal(t, m.International(), "+254110345678")
+	assert.Equal(t, m.Mask(), "254******678")
+
+	for _, s := range []string{"", "12345", "0812345678", "25571234567", "07123456789", "07l2345678"} {
+		_, err := mpesa.ParseMSISDN(s)
+		assert.Assert(t, errors.Is(err, mpesa.ErrInvalidMSISDN), s)
+	}
+	// Airtel number.
+	_, err = mpesa.ParseMSISDN("0733345678")
+	assert.Assert(t, errors.Is(err, mpesa.ErrNotSafaricomMSISDN))
+	m, err = mpesa.NormalizeMSISDN("0733345678")
+	assert.NilError(t, err)
+	assert.Equal(t, m.String(), "254733345678")
+}
+
+func TestMSISDNNormalization(t *testing.T) {
+	var payment mpesa.Payment
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.NilError(t, json.Unmarshal(body, &payment))
+		w.Write([]byte(`{"ResponseCode":"0"}`))
+	})
+
+	s := mpesa.New("key", "secret", srv.URL+"/")
+	_, err := s.MPESAOnlinePayment(mpesa.Payment{PartyA: "0712 345 678", PhoneNumber: "+254712345678"})
+	assert.NilError(t, err)
+	assert.Equal(t, payment.PartyA, "254712345678")
+	assert.Equal(t, payment.PhoneNumber, "254712345678")
+
+	s = mpesa.New("key", "secret", srv.URL+"/", mpesa.WithMSISDNNormalization(false))
+	_, err = s.MPESAOnlinePayment(mpesa.Payment{PartyA: "0712345678"})
+	assert.NilError(t, err)
+	assert.Equal(t, payment.PartyA, "0712345678")
+}
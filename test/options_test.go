@@ -0,0 +1,76 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestTokenStore(t *testing.T) {
+	var oauthCalls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/oauth/") {
+			atomic.AddInt32(&oauthCalls, 1)
+			w.Write([]byte(`{"access_token":"fresh-token","expires_in":"3599"}`))
+			return
+		}
+		assert.Equal(t, r.Header.Get("Authorization"), "Bearer stored-token")
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	store := mpesa.NewMemoryTokenStore()
+	assert.NilError(t, store.SetToken(ctx, mpesa.Token{AccessToken: "stored-token", ExpiresAt: time.Now().Add(time.Hour)}))
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithTokenStore(store))
+
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.NilError(t, err)
+	assert.Equal(t, atomic.LoadInt32(&oauthCalls), int32(0))
+
+	// Expired tokens are refreshed and saved to the store.
+	assert.NilError(t, store.SetToken(ctx, mpesa.Token{AccessToken: "stored-token", ExpiresAt: time.Now().Add(-time.Second)}))
+	token, err := s.GenerateNewAccessToken()
+	assert.NilError(t, err)
+	assert.Equal(t, token, "fresh-token")
+	assert.Equal(t, atomic.LoadInt32(&oauthCalls), int32(1))
+	stored, err := store.Token(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, stored.AccessToken, "fresh-token")
+	assert.Assert(t, stored.Valid(time.Now()))
+}
+
+func TestWithPathAndHeader(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/v2/b2c")
+		assert.Equal(t, r.Header.Get("X-Request-Id"), "req-1")
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithPath(mpesa.APIB2C, "v2/b2c"))
+
+	_, err := s.B2CRequest(mpesa.B2C{}, mpesa.WithHeader("X-Request-Id", "req-1"))
+	assert.NilError(t, err)
+}
+
+func TestWithCallTimeout(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(300 * time.Millisecond):
+		}
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithRetryPolicy(mpesa.RetryPolicy{}))
+
+	start := time.Now()
+	_, err := s.B2CRequest(mpesa.B2C{}, mpesa.WithCallTimeout(50*time.Millisecond))
+	assert.Assert(t, err != nil)
+	assert.Assert(t, time.Since(start) < 250*time.Millisecond, time.Since(start))
+}
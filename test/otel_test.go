@@ -0,0 +1,63 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/devimteam/mpesa-api-go/otelmpesa"
+	"github.com/pkg/errors"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gotest.tools/assert"
+)
+
+func TestTracer_CallbackContinuesTrace(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"MerchantRequestID":"m-1","CheckoutRequestID":"ws_CO_1","ResponseCode":"0"}`))
+	})
+	recorder := tracetest.NewSpanRecorder()
+	tracer := otelmpesa.New(otelmpesa.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))))
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithInterceptor(tracer.Interceptor()))
+
+	_, err := s.MPESAOnlinePayment(mpesa.Payment{BusinessShortCode: mpesaOnlineShortcode})
+	assert.NilError(t, err)
+
+	var cb mpesa.PaymentCallback
+	cb.Body.STKCallback.CheckoutRequestID = "ws_CO_1"
+	_, span := tracer.PaymentCallback(context.Background(), &cb)
+	span.End()
+
+	spans := recorder.Ended()
+	assert.Equal(t, len(spans), 3)
+	push, callback := spans[1], spans[2]
+	assert.Equal(t, push.Name(), "mpesa stk_push")
+	assert.Equal(t, callback.Parent().SpanID(), push.SpanContext().SpanID())
+	assert.Equal(t, callback.SpanContext().TraceID(), push.SpanContext().TraceID())
+}
+
+func TestTracer_EndsSpans(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	recorder := tracetest.NewSpanRecorder()
+	tracer := otelmpesa.New(otelmpesa.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))))
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithInterceptor(
+		tracer.Interceptor(),
+		mpesa.InterceptorFuncs{Before: func(ex *mpesa.Exchange) error {
+			// Replaces the context set by the tracer.
+			ex.Request = ex.Request.WithContext(context.Background())
+			if ex.API == mpesa.APIB2C {
+				return errors.New("rejected")
+			}
+			return nil
+		}},
+	))
+
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.ErrorContains(t, err, "rejected")
+	assert.Equal(t, len(recorder.Started()), 2)
+	assert.Equal(t, len(recorder.Ended()), 2)
+	assert.Equal(t, recorder.Ended()[1].Status().Description, "rejected")
+}
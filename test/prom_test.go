@@ -0,0 +1,63 @@
+package test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/devimteam/mpesa-api-go/prommpesa"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "b2c"):
+			// Zeros are accepted like "0".
+			w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"00000000"}`))
+		case strings.Contains(r.URL.Path, "transactionstatus"):
+			w.Write([]byte(`{"ConversationID":"AG_2","ResponseCode":"1","ResponseDescription":"Rejected"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"requestId":"r-1","errorCode":"400.002.02","errorMessage":"Bad Request - Invalid Amount"}`))
+		}
+	})
+	metrics := prommpesa.New()
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithInterceptor(metrics.Interceptor()))
+
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.NilError(t, err)
+	_, err = s.TransactionStatus(mpesa.TransactionStatus{})
+	assert.NilError(t, err)
+	_, err = s.Reversal(mpesa.Reversal{})
+	assert.Assert(t, err != nil)
+
+	var payment mpesa.PaymentCallback
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"Body":{"stkCallback":{"ResultCode":1032}}}`), &payment))
+	metrics.ObservePaymentCallback(&payment)
+	var b2c mpesa.B2CCallback
+	assert.NilError(t, mpesa.Unmarshal(b2cCallbackJSON, &b2c))
+	metrics.ObserveB2CCallback(&b2c)
+
+	assert.NilError(t, testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP mpesa_requests_total Number of requests to Daraja by API and outcome.
+# TYPE mpesa_requests_total counter
+mpesa_requests_total{api="b2c",outcome="success"} 1
+mpesa_requests_total{api="oauth",outcome="success"} 1
+mpesa_requests_total{api="reversal",outcome="api_error"} 1
+mpesa_requests_total{api="transaction_status",outcome="rejected"} 1
+# HELP mpesa_errors_total Number of Daraja errors by API and error code or ResponseCode.
+# TYPE mpesa_errors_total counter
+mpesa_errors_total{api="reversal",code="400.002.02"} 1
+mpesa_errors_total{api="transaction_status",code="1"} 1
+# HELP mpesa_token_refreshes_total Number of OAuth token refreshes by result.
+# TYPE mpesa_token_refreshes_total counter
+mpesa_token_refreshes_total{result="success"} 1
+# HELP mpesa_callbacks_total Number of received callbacks by type and ResultCode.
+# TYPE mpesa_callbacks_total counter
+mpesa_callbacks_total{result_code="0",type="b2c"} 1
+mpesa_callbacks_total{result_code="1032",type="stk_push"} 1
+`), "mpesa_requests_total", "mpesa_errors_total", "mpesa_token_refreshes_total", "mpesa_callbacks_total"))
+}
@@ -0,0 +1,88 @@
+package test
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestRateLimit_FailFast(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithShortCodeRateLimit("", mpesa.RateLimit{Rate: 0.001, Burst: 1}),
+		mpesa.WithRateLimitFailFast(true),
+	)
+
+	_, err := s.B2CRequest(mpesa.B2C{PartyA: shortCode1})
+	assert.NilError(t, err)
+	_, err = s.B2CRequest(mpesa.B2C{PartyA: shortCode1})
+	assert.Equal(t, err, mpesa.ErrRateLimited)
+	_, err = s.B2CRequest(mpesa.B2C{PartyA: shortCode2})
+	assert.NilError(t, err)
+}
+
+func TestRateLimit_ReturnsTokens(t *testing.T) {
+	var calls int32
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithRateLimit(mpesa.APIB2C, mpesa.RateLimit{Rate: 0.001, Burst: 2}),
+		mpesa.WithShortCodeRateLimit("", mpesa.RateLimit{Rate: 0.001, Burst: 1}),
+		mpesa.WithRateLimitFailFast(true),
+	)
+
+	_, err := s.B2CRequest(mpesa.B2C{PartyA: shortCode1})
+	assert.NilError(t, err)
+	// Rejected by the shortcode bucket, the token of the API bucket is returned.
+	_, err = s.B2CRequest(mpesa.B2C{PartyA: shortCode1})
+	assert.Equal(t, err, mpesa.ErrRateLimited)
+	_, err = s.B2CRequest(mpesa.B2C{PartyA: shortCode2})
+	assert.NilError(t, err)
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(2))
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+func TestRateLimit_Clock(t *testing.T) {
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	clock := &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	s := mpesa.New("key", "secret", srv.URL+"/",
+		mpesa.WithClock(clock),
+		mpesa.WithRateLimit(mpesa.APIB2C, mpesa.RateLimit{Rate: 1, Burst: 1}),
+		mpesa.WithRateLimitFailFast(true),
+	)
+
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.NilError(t, err)
+	_, err = s.B2CRequest(mpesa.B2C{})
+	assert.Equal(t, err, mpesa.ErrRateLimited)
+	clock.Advance(time.Second)
+	_, err = s.B2CRequest(mpesa.B2C{})
+	assert.NilError(t, err)
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestLookupResultCode(t *testing.T) {
+	for _, tc := range []struct {
+		api       mpesa.API
+		code      int
+		outcome   mpesa.Outcome
+		retryable bool
+	}{
+		{mpesa.APISTKPush, 0, mpesa.OutcomeSuccess, false},
+		{mpesa.APIB2C, 0, mpesa.OutcomeSuccess, false},
+		{mpesa.APISTKPush, 1, mpesa.OutcomeInsufficientFunds, false},
+		{mpesa.APISTKPush, 1032, mpesa.OutcomeUserCancelled, false},
+		{mpesa.APISTKPush, 1037, mpesa.OutcomeTimeout, true},
+		{mpesa.APIB2C, 2040, mpesa.OutcomeRejected, false},
+		{mpesa.APIReversal, 17, mpesa.OutcomeSystemError, true},
+		// API-specific codes take precedence over common codes and are not shared between APIs.
+		{mpesa.APISTKPush, 2001, mpesa.OutcomeInvalidPIN, true},
+		{mpesa.APIB2C, 2001, mpesa.OutcomeRejected, false},
+		{mpesa.APIB2C, 1032, mpesa.OutcomeUnknown, false},
+		{mpesa.APISTKPush, 12345, mpesa.OutcomeUnknown, false},
+	} {
+		info := mpesa.LookupResultCode(tc.api, tc.code)
+		assert.Equal(t, info.Code, tc.code)
+		assert.Equal(t, info.Outcome, tc.outcome, "%s %d", tc.api, tc.code)
+		assert.Equal(t, info.Retryable, tc.retryable, "%s %d", tc.api, tc.code)
+		assert.Assert(t, info.Description != "" && info.CustomerMessage != "", "%s %d", tc.api, tc.code)
+	}
+	assert.Equal(t, mpesa.LookupResultCode(mpesa.APISTKPush, 12345).Description, "Unknown result code 12345.")
+}
+
+func TestResultInfo(t *testing.T) {
+	var cb mpesa.PaymentCallback
+	assert.NilError(t, mpesa.Unmarshal([]byte(`{"Body":{"stkCallback":{"ResultCode":2001,"ResultDesc":"The initiator information is invalid."}}}`), &cb))
+	info := cb.ResultInfo()
+	assert.Equal(t, info.Code, 2001)
+	assert.Equal(t, info.Outcome, mpesa.OutcomeInvalidPIN)
+	assert.Assert(t, !info.Success())
+
+	var b2c mpesa.B2CCallback
+	assert.NilError(t, mpesa.Unmarshal(b2cCallbackJSON, &b2c))
+	assert.Assert(t, b2c.ResultInfo().Success())
+
+	for _, tc := range []struct {
+		reply   mpesa.C2BReply
+		outcome mpesa.Outcome
+	}{
+		{mpesa.C2BAccept(), mpesa.OutcomeSuccess},
+		{mpesa.C2BReject(mpesa.C2BRejectInvalidAccountNumber), mpesa.OutcomeRejected},
+		{mpesa.C2BReject(mpesa.C2BRejectOtherError), mpesa.OutcomeRejected},
+		{mpesa.C2BReject("C2B00099"), mpesa.OutcomeUnknown},
+	} {
+		info := tc.reply.ResultInfo()
+		assert.Equal(t, info.C2BCode, tc.reply.ResultCode)
+		assert.Equal(t, info.Outcome, tc.outcome, tc.reply.ResultCode)
+		assert.Assert(t, !info.Retryable)
+	}
+}
@@ -0,0 +1,139 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+// newFakeDaraja returns a server which issues tokens and answers API requests with handler.
+func newFakeDaraja(t testing.TB, handler http.HandlerFunc) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/oauth/") {
+			w.Write([]byte(`{"access_token":"test-token","expires_in":"3599"}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+var fastRetries = mpesa.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}
+
+func TestRetry_SafeCall(t *testing.T) {
+	var calls int32
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"ConversationID":"AG_1","ResponseCode":"0"}`))
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithRetryPolicy(fastRetries))
+
+	resp, err := s.TransactionStatus(mpesa.TransactionStatus{})
+	assert.NilError(t, err)
+	assert.Equal(t, resp.ConversationID, "AG_1")
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(3))
+}
+
+func TestRetry_MoneyMovingCallWithoutKey(t *testing.T) {
+	var calls int32
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithRetryPolicy(fastRetries))
+
+	_, err := s.B2CRequest(mpesa.B2C{})
+	assert.Assert(t, err != nil)
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(1))
+
+	// The key alone does not enable retries without the Checker.
+	_, err = s.B2CRequest(mpesa.B2C{}, mpesa.WithIdempotencyKey("payout-1"))
+	assert.Assert(t, err != nil)
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(2))
+}
+
+func TestRetry_MoneyMovingCallWithKey(t *testing.T) {
+	var calls int32
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Header.Get("Idempotency-Key"), "payout-1")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"ConversationID":"AG_2","ResponseCode":"0"}`))
+	})
+	policy := fastRetries
+	policy.Checker = mpesa.IdempotencyCheckerFunc(func(ctx context.Context, api mpesa.API, key string) (bool, error) {
+		assert.Equal(t, api, mpesa.APIB2C)
+		assert.Equal(t, key, "payout-1")
+		return false, nil
+	})
+	s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithRetryPolicy(policy))
+
+	resp, err := s.B2CRequest(mpesa.B2C{}, mpesa.WithIdempotencyKey("payout-1"))
+	assert.NilError(t, err)
+	assert.Equal(t, resp.ConversationID, "AG_2")
+	assert.Equal(t, atomic.LoadInt32(&calls), int32(2))
+}
+
+func TestRetry_StatusChecker(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		result    string
+		wantCalls int32
+	}{
+		{"not found", `{"Result":{"ResultCode":2001,"ResultDesc":"The transaction does not exist.","ReferenceData":{"ReferenceItem":{"Key":"Occasion","Value":"payout-1"}}}}`, 2},
+		{"processed", `{"Result":{"ResultCode":0,"ResultDesc":"The service request is processed successfully.","ReferenceData":{"ReferenceItem":{"Key":"Occasion","Value":"payout-1"}}}}`, 1},
+		{"other error", `{"Result":{"ResultCode":17,"ResultDesc":"System internal error.","ReferenceData":{"ReferenceItem":{"Key":"Occasion","Value":"payout-1"}}}}`, 1},
+		{"no result", "", 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var checker *mpesa.StatusChecker
+			var calls int32
+			srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+				if strings.Contains(r.URL.Path, "transactionstatus") {
+					var query mpesa.TransactionStatus
+					assert.Check(t, mpesa.Decode(r.Body, &query))
+					assert.Check(t, query.OriginalConversationID == "payout-1")
+					w.Write([]byte(`{"ConversationID":"AG_Q","ResponseCode":"0"}`))
+					if tc.result != "" {
+						// The result is sent to ResultURL after the acknowledgement.
+						go func() {
+							var cb mpesa.TransactionStatusCallback
+							assert.Check(t, mpesa.Unmarshal([]byte(tc.result), &cb))
+							assert.Check(t, checker.HandleResult(&cb))
+						}()
+					}
+					return
+				}
+				var b2c mpesa.B2C
+				assert.Check(t, mpesa.Decode(r.Body, &b2c))
+				assert.Check(t, b2c.OriginatorConversationID == "payout-1")
+				if atomic.AddInt32(&calls, 1) == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.Write([]byte(`{"ConversationID":"AG_2","ResponseCode":"0"}`))
+			})
+			checker = mpesa.NewStatusChecker(mpesa.TransactionStatus{ResultURL: callbackUrl, QueueTimeOutURL: callbackUrl}, 100*time.Millisecond)
+			policy := fastRetries
+			policy.Checker = checker
+			s := mpesa.New("key", "secret", srv.URL+"/", mpesa.WithRetryPolicy(policy))
+
+			_, err := s.B2CRequest(mpesa.B2C{}, mpesa.WithIdempotencyKey("payout-1"))
+			assert.Equal(t, err == nil, tc.wantCalls == 2, err)
+			assert.Equal(t, atomic.LoadInt32(&calls), tc.wantCalls)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devimteam/mpesa-api-go"
+	"gotest.tools/assert"
+)
+
+func TestTimeFormats(t *testing.T) {
+	want := time.Date(2019, 12, 19, 8, 45, 50, 0, time.UTC)
+	for _, s := range []string{
+		"20191219114550",
+		"19.12.2019 11:45:50",
+		"2019-12-19T11:45:50",
+		"2019-12-19 11:45:50",
+		"2019-12-19T08:45:50Z",
+		"2019-12-19T11:45:50+03:00",
+	} {
+		got, err := mpesa.ParseTime(s)
+		assert.NilError(t, err, s)
+		assert.Assert(t, got.Equal(want), "%s: %s", s, got)
+		assert.Equal(t, got.Location(), mpesa.EAT, s)
+	}
+	_, err := mpesa.ParseTime("19/12/2019")
+	assert.ErrorContains(t, err, "invalid time")
+
+	assert.Equal(t, mpesa.Timestamp(want), "20191219114550")
+	assert.Equal(t, mpesa.FormatCompletedDateTime(want), "19.12.2019 11:45:50")
+	assert.Equal(t, mpesa.FormatISO(want), "2019-12-19T11:45:50+03:00")
+
+	req := mpesa.C2BConfirmationRequest{TransTime: "20191219114550"}
+	got, err := req.TransactionTime()
+	assert.NilError(t, err)
+	assert.Assert(t, got.Equal(want))
+}
@@ -0,0 +1,70 @@
+package test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/devimteam/mpesa-api-go"
+	"github.com/pkg/errors"
+	"gotest.tools/assert"
+)
+
+func TestPayment_Validate(t *testing.T) {
+	payment := mpesa.Payment{
+		TransactionType:  "CustomerPayBillOnline",
+		Amount:           mpesa.KES(1),
+		PhoneNumber:      testMSISDN,
+		CallBackURL:      callbackUrl,
+		AccountReference: "auto-testing",
+		TransactionDesc:  "auto-testing",
+	}
+	assert.NilError(t, payment.Validate())
+
+	payment.Amount, _ = mpesa.ParseAmount("1.50")
+	err := payment.Validate()
+	assert.ErrorContains(t, err, "Amount")
+	assert.ErrorContains(t, err, "whole")
+	payment.Amount = mpesa.KES(1)
+
+	payment.TransactionType = "PayBill"
+	payment.PhoneNumber = "12345"
+	payment.CallBackURL = "http://example.com/callback"
+	payment.AccountReference = "auto-testing-1"
+	payment.TransactionDesc = strings.Repeat("x", 14)
+	err = payment.Validate()
+	var verr mpesa.ValidationError
+	assert.Assert(t, errors.As(err, &verr))
+	var fields []string
+	for _, f := range verr {
+		fields = append(fields, f.Field)
+	}
+	assert.DeepEqual(t, fields, []string{"TransactionType", "PhoneNumber", "CallBackURL", "AccountReference", "TransactionDesc"})
+	assert.Equal(t, mpesa.ErrorClassOf(err), mpesa.ErrorClassValidation)
+}
+
+func TestB2C_Validate(t *testing.T) {
+	assert.NilError(t, mpesa.B2C{}.Validate())
+	err := mpesa.B2C{CommandID: "TransactionReversal", Remarks: strings.Repeat("x", 101)}.Validate()
+	assert.ErrorContains(t, err, "CommandID: must be one of SalaryPayment, BusinessPayment, PromotionPayment")
+	assert.ErrorContains(t, err, "Remarks: must be at most 100 characters, got 101")
+}
+
+func TestValidationOptOut(t *testing.T) {
+	var calls int
+	srv := newFakeDaraja(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{}`))
+	})
+	reversal := mpesa.Reversal{QueueTimeOutURL: "ftp://example.com"}
+
+	s := mpesa.New("key", "secret", srv.URL+"/")
+	_, err := s.Reversal(reversal)
+	assert.ErrorContains(t, err, "QueueTimeOutURL: must be an https URL")
+	assert.Equal(t, calls, 0)
+
+	s = mpesa.New("key", "secret", srv.URL+"/", mpesa.WithValidation(false))
+	_, err = s.Reversal(reversal)
+	assert.NilError(t, err)
+	assert.Equal(t, calls, 1)
+}
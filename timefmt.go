@@ -0,0 +1,86 @@
+package mpesa
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Layouts of Daraja times. All of them are in East Africa Time.
+const (
+	// TimestampLayout is the layout of request timestamps, C2B TransTime and STK TransactionDate,
+	// e.g. "20170727104247".
+	TimestampLayout = "20060102150405"
+	// CompletedDateTimeLayout is the layout of B2C TransactionCompletedDateTime, e.g. "19.12.2019 11:45:50".
+	CompletedDateTimeLayout = "02.01.2006 15:04:05"
+	// ISOLayout is the layout of ISO times without the zone, e.g. "2019-12-19T11:45:50".
+	ISOLayout = "2006-01-02T15:04:05"
+)
+
+// EAT is the Africa/Nairobi time zone of Daraja times.
+// If tzdata is not available, the fixed UTC+3 zone is used: Kenya does not observe daylight saving time.
+var EAT = loadEAT()
+
+func loadEAT() *time.Location {
+	if loc, err := time.LoadLocation("Africa/Nairobi"); err == nil {
+		return loc
+	}
+	return time.FixedZone("EAT", 3*60*60)
+}
+
+// Timestamp formats t in EAT as the request timestamp, e.g. "20170727104247".
+func Timestamp(t time.Time) string {
+	return t.In(EAT).Format(TimestampLayout)
+}
+
+// ParseTimestamp parses the timestamp in EAT, e.g. C2B TransTime "20170727104247"
+// or STK TransactionDate 20191219102115.
+func ParseTimestamp(s string) (time.Time, error) {
+	return parseTime(TimestampLayout, s)
+}
+
+// FormatCompletedDateTime formats t in EAT like B2C TransactionCompletedDateTime, e.g. "19.12.2019 11:45:50".
+func FormatCompletedDateTime(t time.Time) string {
+	return t.In(EAT).Format(CompletedDateTimeLayout)
+}
+
+// ParseCompletedDateTime parses B2C TransactionCompletedDateTime in EAT, e.g. "19.12.2019 11:45:50".
+func ParseCompletedDateTime(s string) (time.Time, error) {
+	return parseTime(CompletedDateTimeLayout, s)
+}
+
+// FormatISO formats t in EAT as RFC 3339 time, e.g. "2019-12-19T11:45:50+03:00".
+func FormatISO(t time.Time) string {
+	return t.In(EAT).Format(time.RFC3339)
+}
+
+// ParseISO parses the ISO time. Times without the zone, e.g. "2019-12-19T11:45:50" or "2019-12-19 11:45:50",
+// are in EAT. The result is in EAT.
+func ParseISO(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.In(EAT), nil
+	}
+	return parseTime(ISOLayout, strings.Replace(s, " ", "T", 1))
+}
+
+// ParseTime parses the time in any of Daraja formats.
+func ParseTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case len(s) == len(TimestampLayout):
+		return ParseTimestamp(s)
+	case strings.Count(s, ".") >= 2 && len(s) == len(CompletedDateTimeLayout):
+		return ParseCompletedDateTime(s)
+	}
+	return ParseISO(s)
+}
+
+func parseTime(layout, s string) (time.Time, error) {
+	t, err := time.ParseInLocation(layout, strings.TrimSpace(s), EAT)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid time %q: %s layout is expected", s, layout)
+	}
+	return t, nil
+}
@@ -0,0 +1,50 @@
+package mpesa
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Token is an OAuth access token issued by Daraja.
+type Token struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+// Valid reports whether the token may be used at the moment now.
+func (t Token) Valid(now time.Time) bool {
+	return t.AccessToken != "" && now.Before(t.ExpiresAt)
+}
+
+// TokenStore keeps the current access token.
+// Implementations must be safe for concurrent use.
+type TokenStore interface {
+	// Token returns the stored token or zero Token if there is no one.
+	Token(ctx context.Context) (Token, error)
+	SetToken(ctx context.Context, token Token) error
+}
+
+// NewMemoryTokenStore returns a TokenStore which keeps the token in memory.
+// It is the default store of the Service.
+func NewMemoryTokenStore() TokenStore {
+	return &memoryTokenStore{}
+}
+
+type memoryTokenStore struct {
+	mu    sync.RWMutex
+	token Token
+}
+
+func (m *memoryTokenStore) Token(context.Context) (Token, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.token, nil
+}
+
+func (m *memoryTokenStore) SetToken(_ context.Context, token Token) error {
+	m.mu.Lock()
+	m.token = token
+	m.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,183 @@
+package mpesa
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// FieldError describes an invalid field of the request.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError is returned by Validate methods of requests with all invalid fields.
+// Only fields which are set are checked, missing fields are reported by Daraja.
+// Its ErrorClass is ErrorClassValidation.
+type ValidationError []FieldError
+
+func (e ValidationError) Error() string {
+	msgs := make([]string, len(e))
+	for i, f := range e {
+		msgs[i] = f.Error()
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// WithValidation enables or disables validation of requests before sending. It is enabled by default.
+func WithValidation(validate bool) Option {
+	return func(s *Service) {
+		s.validate = validate
+	}
+}
+
+// Validate checks the limits of the C2B simulation request.
+func (r C2B) Validate() error {
+	var v validator
+	v.command("CommandID", APIC2BSimulate, r.CommandID)
+	v.amount("Amount", r.Amount)
+	v.msisdn("Msisdn", r.Msisdn)
+	return v.err()
+}
+
+// Validate checks the limits of the B2C request.
+func (r B2C) Validate() error {
+	var v validator
+	v.command("CommandID", APIB2C, r.CommandID)
+	v.amount("Amount", r.Amount)
+	v.msisdn("PartyB", r.PartyB)
+	v.maxLen("Remarks", r.Remarks, 100)
+	v.url("QueueTimeOutURL", r.QueueTimeOutURL)
+	v.url("ResultURL", r.ResultURL)
+	v.maxLen("Occasion", r.Occasion, 100)
+	return v.err()
+}
+
+// Validate checks the limits of the transaction status request.
+func (r TransactionStatus) Validate() error {
+	var v validator
+	v.command("CommandID", APITransactionStatus, r.CommandID)
+	v.identifier("IdentifierType", APITransactionStatus, r.IdentifierType)
+	v.maxLen("Remarks", r.Remarks, 100)
+	v.url("QueueTimeOutURL", r.QueueTimeOutURL)
+	v.url("ResultURL", r.ResultURL)
+	v.maxLen("Occasion", r.Occasion, 100)
+	return v.err()
+}
+
+// Validate checks the limits of the M-Pesa Express request.
+func (r Payment) Validate() error {
+	var v validator
+	v.command("TransactionType", APISTKPush, r.TransactionType)
+	v.amount("Amount", r.Amount)
+	if !r.Amount.IsWhole() {
+		v.add("Amount", "must be whole, M-Pesa Express supports only whole amounts")
+	}
+	v.msisdn("PartyA", r.PartyA)
+	v.msisdn("PhoneNumber", r.PhoneNumber)
+	v.url("CallBackURL", r.CallBackURL)
+	v.maxLen("AccountReference", r.AccountReference, 12)
+	v.maxLen("TransactionDesc", r.TransactionDesc, 13)
+	return v.err()
+}
+
+// Validate checks the limits of the URL registration request.
+func (r C2BRegisterURL) Validate() error {
+	var v validator
+	if r.ResponseType != "" && !r.ResponseType.Valid() {
+		v.add("ResponseType", "must be one of %s, %s, got %q", ResponseCompleted, ResponseCancelled, r.ResponseType)
+	}
+	v.url("ConfirmationURL", r.ConfirmationURL)
+	v.url("ValidationURL", r.ValidationURL)
+	return v.err()
+}
+
+// Validate checks the limits of the reversal request.
+func (r Reversal) Validate() error {
+	var v validator
+	v.command("CommandID", APIReversal, r.CommandID)
+	v.identifier("ReceiverIdentifierType", APIReversal, r.ReceiverIdentifierType)
+	v.maxLen("Remarks", r.Remarks, 100)
+	v.url("QueueTimeOutURL", r.QueueTimeOutURL)
+	v.url("ResultURL", r.ResultURL)
+	v.maxLen("Occasion", r.Occasion, 100)
+	return v.err()
+}
+
+type validator struct {
+	errs ValidationError
+}
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) maxLen(field, value string, n int) {
+	if l := utf8.RuneCountInString(value); l > n {
+		v.add(field, "must be at most %d characters, got %d", n, l)
+	}
+}
+
+func (v *validator) command(field string, api API, value CommandID) {
+	if value == "" || value.ValidFor(api) {
+		return
+	}
+	allowed := make([]string, len(commandIDs[api]))
+	for i, c := range commandIDs[api] {
+		allowed[i] = string(c)
+	}
+	v.add(field, "must be one of %s, got %q", strings.Join(allowed, ", "), value)
+}
+
+func (v *validator) identifier(field string, api API, value IdentifierType) {
+	if value == 0 || value.ValidFor(api) {
+		return
+	}
+	allowed := make([]string, len(identifierTypes[api]))
+	for i, t := range identifierTypes[api] {
+		allowed[i] = t.String()
+	}
+	v.add(field, "must be one of %s, got %d", strings.Join(allowed, ", "), value)
+}
+
+func (v *validator) url(field, value string) {
+	if value == "" {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" {
+		v.add(field, "must be a valid URL")
+		return
+	}
+	if u.Scheme != "https" {
+		v.add(field, "must be an https URL")
+	}
+}
+
+func (v *validator) msisdn(field, value string) {
+	if value == "" {
+		return
+	}
+	if _, err := NormalizeMSISDN(value); err != nil {
+		v.add(field, "must be a Kenyan mobile number in the format 2547XXXXXXXX")
+	}
+}
+
+func (v *validator) amount(field string, value Amount) {
+	if value < 0 {
+		v.add(field, "must not be negative")
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}